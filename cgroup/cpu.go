@@ -0,0 +1,35 @@
+package cgroup
+
+import (
+	yaml "gopkg.in/yaml.v2"
+)
+
+// GetCPUStat reads '/sys/fs/cgroup/$CGROUP/cpu.stat'.
+func GetCPUStat(cgroupPath string) (CPUStat, error) {
+	return defaultFS.GetCPUStat(cgroupPath)
+}
+
+// GetCPUStat reads '$ROOT/$CGROUP/cpu.stat'.
+func (fs FS) GetCPUStat(cgroupPath string) (CPUStat, error) {
+	d, err := readFile(fs.path(cgroupPath, "cpu.stat"))
+	if err != nil {
+		return CPUStat{}, err
+	}
+	return parseCPUStat(d)
+}
+
+func parseCPUStat(d []byte) (CPUStat, error) {
+	y, err := flatKeyedToYAML(d)
+	if err != nil {
+		return CPUStat{}, err
+	}
+	var s CPUStat
+	if err = yaml.Unmarshal(y, &s); err != nil {
+		return CPUStat{}, err
+	}
+	s.UsageUsecParsedTime = humanizeMicroseconds(s.UsageUsec)
+	s.UserUsecParsedTime = humanizeMicroseconds(s.UserUsec)
+	s.SystemUsecParsedTime = humanizeMicroseconds(s.SystemUsec)
+	s.ThrottledUsecParsedTime = humanizeMicroseconds(s.ThrottledUsec)
+	return s, nil
+}
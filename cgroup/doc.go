@@ -0,0 +1,3 @@
+// Package cgroup represents Linux cgroup v2 '/sys/fs/cgroup'.
+// Reference https://www.kernel.org/doc/Documentation/cgroup-v2.txt.
+package cgroup
@@ -0,0 +1,43 @@
+package cgroup
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// DefaultRoot is the default cgroup v2 mount point.
+const DefaultRoot = "/sys/fs/cgroup"
+
+// FS represents a cgroup v2 tree mounted at a root path.
+// Use this to read the unified hierarchy mounted elsewhere
+// (e.g. '/sys/fs/cgroup/unified' in hybrid mode), or to read
+// captured fixture trees.
+type FS struct {
+	root string
+}
+
+// defaultFS reads from '/sys/fs/cgroup'; package-level readers wrap this.
+var defaultFS = FS{root: DefaultRoot}
+
+// NewFS returns a new FS rooted at the given path.
+func NewFS(root string) (FS, error) {
+	if root == "" {
+		root = DefaultRoot
+	}
+	if _, err := os.Stat(root); err != nil {
+		return FS{}, err
+	}
+	return FS{root: filepath.Clean(root)}, nil
+}
+
+// Root returns the cgroup root path.
+func (fs FS) Root() string {
+	return fs.root
+}
+
+// path returns '$ROOT/$CGROUP/$FILE', where the cgroup path is
+// relative to the root, as in '/proc/$PID/cgroup'
+// (e.g. '/system.slice/docker-$ID.scope').
+func (fs FS) path(cgroupPath, file string) string {
+	return filepath.Join(fs.root, cgroupPath, file)
+}
@@ -0,0 +1,120 @@
+package cgroup
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFS(t *testing.T) {
+	root, err := ioutil.TempDir(os.TempDir(), "cgroup-fs-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	cg := "/system.slice/docker-0123.scope"
+	files := map[string]string{
+		"cpu.stat":        "usage_usec 2000000\nuser_usec 1500000\nsystem_usec 500000\nnr_periods 100\nnr_throttled 25\nthrottled_usec 300000\n",
+		"memory.current":  "104857600\n",
+		"memory.max":      "max\n",
+		"memory.stat":     "anon 4096\nfile 8192\nkernel_stack 16384\nsock 0\nunknown_in_newer_kernel 1\npgfault 10\n",
+		"memory.events":   "low 0\nhigh 0\nmax 3\noom 1\noom_kill 1\n",
+		"io.stat":         "8:0 rbytes=1048576 wbytes=2048 rios=10 wios=2 dbytes=0 dios=0\n253:0 rbytes=4096 wbytes=0 rios=1 wios=0 dbytes=0 dios=0\n",
+		"pids.current":    "7\n",
+		"pids.max":        "100\n",
+		"cpu.pressure":    "some avg10=1.50 avg60=0.75 avg300=0.10 total=123456\n",
+		"memory.pressure": "some avg10=0.00 avg60=0.00 avg300=0.00 total=0\nfull avg10=0.00 avg60=0.00 avg300=0.00 total=0\n",
+	}
+	for name, txt := range files {
+		fpath := filepath.Join(root, cg, name)
+		if err = os.MkdirAll(filepath.Dir(fpath), 0777); err != nil {
+			t.Fatal(err)
+		}
+		if err = ioutil.WriteFile(fpath, []byte(txt), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	fs, err := NewFS(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cs, err := fs.GetCPUStat(cg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cs.UsageUsec != 2000000 || cs.NrPeriods != 100 || cs.NrThrottled != 25 || cs.ThrottledUsec != 300000 {
+		t.Fatalf("unexpected cpu.stat %+v", cs)
+	}
+	if cs.UsageUsecParsedTime != "2s" || cs.ThrottledUsecParsedTime != "300ms" {
+		t.Fatalf("unexpected parsed time %+v", cs)
+	}
+
+	cur, err := fs.GetMemoryCurrent(cg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cur != 104857600 {
+		t.Fatalf("memory.current expected 104857600, got %d", cur)
+	}
+	max, err := fs.GetMemoryMax(cg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if max != Max {
+		t.Fatalf("memory.max expected Max, got %d", max)
+	}
+
+	ms, err := fs.GetMemoryStat(cg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ms.Anon != 4096 || ms.FileBytesN != 8192 || ms.KernelStackParsedBytes != "16 kB" || ms.Pgfault != 10 {
+		t.Fatalf("unexpected memory.stat %+v", ms)
+	}
+
+	ev, err := fs.GetMemoryEvents(cg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ev.Max != 3 || ev.Oom != 1 || ev.OomKill != 1 {
+		t.Fatalf("unexpected memory.events %+v", ev)
+	}
+
+	ios, err := fs.GetIOStat(cg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ios) != 2 || ios[0].Device != "8:0" || ios[0].RbytesBytesN != 1048576 || ios[0].RbytesParsedBytes != "1.0 MB" || ios[1].Rios != 1 {
+		t.Fatalf("unexpected io.stat %+v", ios)
+	}
+
+	pc, err := fs.GetPidsCurrent(cg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pm, err := fs.GetPidsMax(cg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pc != 7 || pm != 100 {
+		t.Fatalf("pids expected 7/100, got %d/%d", pc, pm)
+	}
+
+	cp, err := fs.GetCPUPressure(cg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cp.Some.Avg10 != 1.5 || cp.Some.Total != 123456 || cp.Full.Total != 0 {
+		t.Fatalf("unexpected cpu.pressure %+v", cp)
+	}
+	if _, err = fs.GetMemoryPressure(cg); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = fs.GetIOPressure(cg); !os.IsNotExist(err) {
+		t.Fatalf("expected not exist error, got %v", err)
+	}
+}
@@ -0,0 +1,141 @@
+package cgroup
+
+// updated at 2026-10-17 14:10:05.776515926 -0700 PDT
+
+// CPUStat is 'cpu.stat' in cgroup v2.
+type CPUStat struct {
+	// UsageUsec is total CPU time consumed by the cgroup.
+	UsageUsec           uint64 `yaml:"usage_usec"`
+	UsageUsecParsedTime string `yaml:"usage_usec_parsed_time"`
+	// UserUsec is CPU time consumed in user mode.
+	UserUsec           uint64 `yaml:"user_usec"`
+	UserUsecParsedTime string `yaml:"user_usec_parsed_time"`
+	// SystemUsec is CPU time consumed in kernel mode.
+	SystemUsec           uint64 `yaml:"system_usec"`
+	SystemUsecParsedTime string `yaml:"system_usec_parsed_time"`
+	// NrPeriods is number of enforcement intervals that have elapsed (with 'cpu.max' quota).
+	NrPeriods uint64 `yaml:"nr_periods"`
+	// NrThrottled is number of times the cgroup has been throttled.
+	NrThrottled uint64 `yaml:"nr_throttled"`
+	// ThrottledUsec is total time the cgroup has been throttled.
+	ThrottledUsec           uint64 `yaml:"throttled_usec"`
+	ThrottledUsecParsedTime string `yaml:"throttled_usec_parsed_time"`
+	// NrBursts is number of periods that bursts occurred.
+	NrBursts uint64 `yaml:"nr_bursts"`
+	// BurstUsec is total time that bursts exceeded the quota.
+	BurstUsec uint64 `yaml:"burst_usec"`
+}
+
+// MemoryStat is 'memory.stat' in cgroup v2.
+type MemoryStat struct {
+	// Anon is amount of memory used in anonymous mappings.
+	Anon            uint64 `yaml:"anon"`
+	AnonBytesN      uint64 `yaml:"anon_bytes_n"`
+	AnonParsedBytes string `yaml:"anon_parsed_bytes"`
+	// File is amount of memory used to cache filesystem data.
+	File            uint64 `yaml:"file"`
+	FileBytesN      uint64 `yaml:"file_bytes_n"`
+	FileParsedBytes string `yaml:"file_parsed_bytes"`
+	// KernelStack is amount of memory allocated to kernel stacks.
+	KernelStack            uint64 `yaml:"kernel_stack"`
+	KernelStackBytesN      uint64 `yaml:"kernel_stack_bytes_n"`
+	KernelStackParsedBytes string `yaml:"kernel_stack_parsed_bytes"`
+	// Pagetables is amount of memory allocated for page tables.
+	Pagetables            uint64 `yaml:"pagetables"`
+	PagetablesBytesN      uint64 `yaml:"pagetables_bytes_n"`
+	PagetablesParsedBytes string `yaml:"pagetables_parsed_bytes"`
+	// Sock is amount of memory used in network transmission buffers.
+	Sock            uint64 `yaml:"sock"`
+	SockBytesN      uint64 `yaml:"sock_bytes_n"`
+	SockParsedBytes string `yaml:"sock_parsed_bytes"`
+	// Shmem is amount of cached filesystem data that is swap-backed.
+	Shmem            uint64 `yaml:"shmem"`
+	ShmemBytesN      uint64 `yaml:"shmem_bytes_n"`
+	ShmemParsedBytes string `yaml:"shmem_parsed_bytes"`
+	// FileMapped is amount of cached filesystem data mapped with mmap.
+	FileMapped            uint64 `yaml:"file_mapped"`
+	FileMappedBytesN      uint64 `yaml:"file_mapped_bytes_n"`
+	FileMappedParsedBytes string `yaml:"file_mapped_parsed_bytes"`
+	// FileDirty is amount of cached filesystem data that was modified but not yet written back to disk.
+	FileDirty            uint64 `yaml:"file_dirty"`
+	FileDirtyBytesN      uint64 `yaml:"file_dirty_bytes_n"`
+	FileDirtyParsedBytes string `yaml:"file_dirty_parsed_bytes"`
+	// FileWriteback is amount of cached filesystem data that is being written back to disk.
+	FileWriteback            uint64 `yaml:"file_writeback"`
+	FileWritebackBytesN      uint64 `yaml:"file_writeback_bytes_n"`
+	FileWritebackParsedBytes string `yaml:"file_writeback_parsed_bytes"`
+	// AnonThp is amount of memory used in anonymous mappings backed by transparent hugepages.
+	AnonThp            uint64 `yaml:"anon_thp"`
+	AnonThpBytesN      uint64 `yaml:"anon_thp_bytes_n"`
+	AnonThpParsedBytes string `yaml:"anon_thp_parsed_bytes"`
+	// InactiveAnon is amount of anonymous memory on the inactive LRU list.
+	InactiveAnon            uint64 `yaml:"inactive_anon"`
+	InactiveAnonBytesN      uint64 `yaml:"inactive_anon_bytes_n"`
+	InactiveAnonParsedBytes string `yaml:"inactive_anon_parsed_bytes"`
+	// ActiveAnon is amount of anonymous memory on the active LRU list.
+	ActiveAnon            uint64 `yaml:"active_anon"`
+	ActiveAnonBytesN      uint64 `yaml:"active_anon_bytes_n"`
+	ActiveAnonParsedBytes string `yaml:"active_anon_parsed_bytes"`
+	// InactiveFile is amount of file-backed memory on the inactive LRU list.
+	InactiveFile            uint64 `yaml:"inactive_file"`
+	InactiveFileBytesN      uint64 `yaml:"inactive_file_bytes_n"`
+	InactiveFileParsedBytes string `yaml:"inactive_file_parsed_bytes"`
+	// ActiveFile is amount of file-backed memory on the active LRU list.
+	ActiveFile            uint64 `yaml:"active_file"`
+	ActiveFileBytesN      uint64 `yaml:"active_file_bytes_n"`
+	ActiveFileParsedBytes string `yaml:"active_file_parsed_bytes"`
+	// Unevictable is amount of memory that cannot be reclaimed.
+	Unevictable            uint64 `yaml:"unevictable"`
+	UnevictableBytesN      uint64 `yaml:"unevictable_bytes_n"`
+	UnevictableParsedBytes string `yaml:"unevictable_parsed_bytes"`
+	// SlabReclaimable is part of slab that might be reclaimed.
+	SlabReclaimable            uint64 `yaml:"slab_reclaimable"`
+	SlabReclaimableBytesN      uint64 `yaml:"slab_reclaimable_bytes_n"`
+	SlabReclaimableParsedBytes string `yaml:"slab_reclaimable_parsed_bytes"`
+	// SlabUnreclaimable is part of slab that cannot be reclaimed on memory pressure.
+	SlabUnreclaimable            uint64 `yaml:"slab_unreclaimable"`
+	SlabUnreclaimableBytesN      uint64 `yaml:"slab_unreclaimable_bytes_n"`
+	SlabUnreclaimableParsedBytes string `yaml:"slab_unreclaimable_parsed_bytes"`
+	// Pgfault is total number of page faults.
+	Pgfault uint64 `yaml:"pgfault"`
+	// Pgmajfault is number of major page faults.
+	Pgmajfault uint64 `yaml:"pgmajfault"`
+}
+
+// MemoryEvents is 'memory.events' in cgroup v2.
+type MemoryEvents struct {
+	// Low is number of times the cgroup is reclaimed due to high memory pressure even though its usage is under the low boundary.
+	Low uint64 `yaml:"low"`
+	// High is number of times processes of the cgroup are throttled and routed to perform direct memory reclaim because the high memory boundary was exceeded.
+	High uint64 `yaml:"high"`
+	// Max is number of times the cgroup's memory usage was about to go over the max boundary.
+	Max uint64 `yaml:"max"`
+	// Oom is number of times the cgroup's memory usage reached the limit and allocation was about to fail.
+	Oom uint64 `yaml:"oom"`
+	// OomKill is number of processes belonging to this cgroup killed by any kind of OOM killer.
+	OomKill uint64 `yaml:"oom_kill"`
+}
+
+// IOStat is a device line in 'io.stat' in cgroup v2.
+type IOStat struct {
+	// Device is 'major:minor' of the device.
+	Device string `column:"device"`
+	// Rbytes is bytes read.
+	Rbytes            uint64 `column:"rbytes"`
+	RbytesBytesN      uint64 `column:"rbytes_bytes_n"`
+	RbytesParsedBytes string `column:"rbytes_parsed_bytes"`
+	// Wbytes is bytes written.
+	Wbytes            uint64 `column:"wbytes"`
+	WbytesBytesN      uint64 `column:"wbytes_bytes_n"`
+	WbytesParsedBytes string `column:"wbytes_parsed_bytes"`
+	// Rios is number of read IOs.
+	Rios uint64 `column:"rios"`
+	// Wios is number of write IOs.
+	Wios uint64 `column:"wios"`
+	// Dbytes is bytes discarded.
+	Dbytes            uint64 `column:"dbytes"`
+	DbytesBytesN      uint64 `column:"dbytes_bytes_n"`
+	DbytesParsedBytes string `column:"dbytes_parsed_bytes"`
+	// Dios is number of discard IOs.
+	Dios uint64 `column:"dios"`
+}
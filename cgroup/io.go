@@ -0,0 +1,67 @@
+package cgroup
+
+import (
+	"bufio"
+	"bytes"
+	"strconv"
+	"strings"
+
+	humanize "github.com/dustin/go-humanize"
+)
+
+// GetIOStat reads '/sys/fs/cgroup/$CGROUP/io.stat'.
+// Devices without any IO are not listed.
+func GetIOStat(cgroupPath string) ([]IOStat, error) {
+	return defaultFS.GetIOStat(cgroupPath)
+}
+
+// GetIOStat reads '$ROOT/$CGROUP/io.stat'.
+func (fs FS) GetIOStat(cgroupPath string) ([]IOStat, error) {
+	d, err := readFile(fs.path(cgroupPath, "io.stat"))
+	if err != nil {
+		return nil, err
+	}
+	return parseIOStat(d)
+}
+
+// parseIOStat parses '8:0 rbytes=1024 wbytes=0 rios=1 wios=0 dbytes=0 dios=0'.
+func parseIOStat(d []byte) ([]IOStat, error) {
+	var ss []IOStat
+	scanner := bufio.NewScanner(bytes.NewReader(d))
+	for scanner.Scan() {
+		txt := scanner.Text()
+		if strings.TrimSpace(txt) == "" {
+			continue
+		}
+		dev, kvs, err := parseNestedKeyed(txt)
+		if err != nil {
+			return nil, err
+		}
+		s := IOStat{Device: dev}
+		for _, f := range []struct {
+			key string
+			v   *uint64
+		}{
+			{"rbytes", &s.Rbytes},
+			{"wbytes", &s.Wbytes},
+			{"rios", &s.Rios},
+			{"wios", &s.Wios},
+			{"dbytes", &s.Dbytes},
+			{"dios", &s.Dios},
+		} {
+			v, ok := kvs[f.key]
+			if !ok {
+				// discard stats are not available in older kernels
+				continue
+			}
+			if *f.v, err = strconv.ParseUint(v, 10, 64); err != nil {
+				return nil, err
+			}
+		}
+		s.RbytesBytesN, s.RbytesParsedBytes = s.Rbytes, humanize.Bytes(s.Rbytes)
+		s.WbytesBytesN, s.WbytesParsedBytes = s.Wbytes, humanize.Bytes(s.Wbytes)
+		s.DbytesBytesN, s.DbytesParsedBytes = s.Dbytes, humanize.Bytes(s.Dbytes)
+		ss = append(ss, s)
+	}
+	return ss, scanner.Err()
+}
@@ -0,0 +1,22 @@
+package cgroup
+
+import "testing"
+
+func TestParseIOStat(t *testing.T) {
+	// discard stats are not available before Linux 5.0
+	ss, err := parseIOStat([]byte("8:16 rbytes=4096 wbytes=8192 rios=1 wios=2\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ss) != 1 {
+		t.Fatalf("expected 1 device, got %+v", ss)
+	}
+	s := ss[0]
+	if s.Device != "8:16" || s.Rbytes != 4096 || s.WbytesBytesN != 8192 || s.Wios != 2 || s.Dbytes != 0 || s.DbytesParsedBytes != "0 B" {
+		t.Fatalf("unexpected %+v", s)
+	}
+
+	if _, err = parseIOStat([]byte("8:16 rbytes=x\n")); err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -0,0 +1,92 @@
+package cgroup
+
+import (
+	"reflect"
+
+	"github.com/gyuho/linux-inspect/schema"
+
+	humanize "github.com/dustin/go-humanize"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// GetMemoryCurrent reads '/sys/fs/cgroup/$CGROUP/memory.current',
+// the total memory usage of the cgroup and its descendants in bytes.
+func GetMemoryCurrent(cgroupPath string) (uint64, error) {
+	return defaultFS.GetMemoryCurrent(cgroupPath)
+}
+
+// GetMemoryCurrent reads '$ROOT/$CGROUP/memory.current'.
+func (fs FS) GetMemoryCurrent(cgroupPath string) (uint64, error) {
+	return readUint(fs.path(cgroupPath, "memory.current"))
+}
+
+// GetMemoryMax reads '/sys/fs/cgroup/$CGROUP/memory.max',
+// the memory usage hard limit in bytes. It returns 'Max'
+// if there is no limit.
+func GetMemoryMax(cgroupPath string) (uint64, error) {
+	return defaultFS.GetMemoryMax(cgroupPath)
+}
+
+// GetMemoryMax reads '$ROOT/$CGROUP/memory.max'.
+func (fs FS) GetMemoryMax(cgroupPath string) (uint64, error) {
+	return readUint(fs.path(cgroupPath, "memory.max"))
+}
+
+// GetMemoryStat reads '/sys/fs/cgroup/$CGROUP/memory.stat'.
+func GetMemoryStat(cgroupPath string) (MemoryStat, error) {
+	return defaultFS.GetMemoryStat(cgroupPath)
+}
+
+// GetMemoryStat reads '$ROOT/$CGROUP/memory.stat'.
+func (fs FS) GetMemoryStat(cgroupPath string) (MemoryStat, error) {
+	d, err := readFile(fs.path(cgroupPath, "memory.stat"))
+	if err != nil {
+		return MemoryStat{}, err
+	}
+	return parseMemoryStat(d)
+}
+
+func parseMemoryStat(d []byte) (MemoryStat, error) {
+	y, err := flatKeyedToYAML(d)
+	if err != nil {
+		return MemoryStat{}, err
+	}
+	var s MemoryStat
+	if err = yaml.Unmarshal(y, &s); err != nil {
+		return MemoryStat{}, err
+	}
+
+	val := reflect.ValueOf(&s).Elem()
+	for name, tp := range MemoryStatSchema.ColumnsToParse {
+		if tp != schema.TypeBytes {
+			continue
+		}
+		column := schema.ToField(name)
+		u := val.FieldByName(column).Uint()
+		val.FieldByName(column + "BytesN").SetUint(u)
+		val.FieldByName(column + "ParsedBytes").SetString(humanize.Bytes(u))
+	}
+	return s, nil
+}
+
+// GetMemoryEvents reads '/sys/fs/cgroup/$CGROUP/memory.events'.
+func GetMemoryEvents(cgroupPath string) (MemoryEvents, error) {
+	return defaultFS.GetMemoryEvents(cgroupPath)
+}
+
+// GetMemoryEvents reads '$ROOT/$CGROUP/memory.events'.
+func (fs FS) GetMemoryEvents(cgroupPath string) (MemoryEvents, error) {
+	d, err := readFile(fs.path(cgroupPath, "memory.events"))
+	if err != nil {
+		return MemoryEvents{}, err
+	}
+	y, err := flatKeyedToYAML(d)
+	if err != nil {
+		return MemoryEvents{}, err
+	}
+	var ev MemoryEvents
+	if err = yaml.Unmarshal(y, &ev); err != nil {
+		return MemoryEvents{}, err
+	}
+	return ev, nil
+}
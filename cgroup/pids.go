@@ -0,0 +1,24 @@
+package cgroup
+
+// GetPidsCurrent reads '/sys/fs/cgroup/$CGROUP/pids.current',
+// the number of processes in the cgroup and its descendants.
+func GetPidsCurrent(cgroupPath string) (uint64, error) {
+	return defaultFS.GetPidsCurrent(cgroupPath)
+}
+
+// GetPidsCurrent reads '$ROOT/$CGROUP/pids.current'.
+func (fs FS) GetPidsCurrent(cgroupPath string) (uint64, error) {
+	return readUint(fs.path(cgroupPath, "pids.current"))
+}
+
+// GetPidsMax reads '/sys/fs/cgroup/$CGROUP/pids.max',
+// the hard limit of the number of processes. It returns 'Max'
+// if there is no limit.
+func GetPidsMax(cgroupPath string) (uint64, error) {
+	return defaultFS.GetPidsMax(cgroupPath)
+}
+
+// GetPidsMax reads '$ROOT/$CGROUP/pids.max'.
+func (fs FS) GetPidsMax(cgroupPath string) (uint64, error) {
+	return readUint(fs.path(cgroupPath, "pids.max"))
+}
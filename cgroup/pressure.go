@@ -0,0 +1,41 @@
+package cgroup
+
+import "github.com/gyuho/linux-inspect/proc"
+
+// GetCPUPressure reads '/sys/fs/cgroup/$CGROUP/cpu.pressure'.
+func GetCPUPressure(cgroupPath string) (proc.Pressure, error) {
+	return defaultFS.GetCPUPressure(cgroupPath)
+}
+
+// GetCPUPressure reads '$ROOT/$CGROUP/cpu.pressure'.
+func (fs FS) GetCPUPressure(cgroupPath string) (proc.Pressure, error) {
+	return getPressure(fs.path(cgroupPath, "cpu.pressure"))
+}
+
+// GetMemoryPressure reads '/sys/fs/cgroup/$CGROUP/memory.pressure'.
+func GetMemoryPressure(cgroupPath string) (proc.Pressure, error) {
+	return defaultFS.GetMemoryPressure(cgroupPath)
+}
+
+// GetMemoryPressure reads '$ROOT/$CGROUP/memory.pressure'.
+func (fs FS) GetMemoryPressure(cgroupPath string) (proc.Pressure, error) {
+	return getPressure(fs.path(cgroupPath, "memory.pressure"))
+}
+
+// GetIOPressure reads '/sys/fs/cgroup/$CGROUP/io.pressure'.
+func GetIOPressure(cgroupPath string) (proc.Pressure, error) {
+	return defaultFS.GetIOPressure(cgroupPath)
+}
+
+// GetIOPressure reads '$ROOT/$CGROUP/io.pressure'.
+func (fs FS) GetIOPressure(cgroupPath string) (proc.Pressure, error) {
+	return getPressure(fs.path(cgroupPath, "io.pressure"))
+}
+
+func getPressure(fpath string) (proc.Pressure, error) {
+	d, err := readFile(fpath)
+	if err != nil {
+		return proc.Pressure{}, err
+	}
+	return proc.ParsePressure(d)
+}
@@ -0,0 +1,110 @@
+package cgroup
+
+import (
+	"reflect"
+
+	"github.com/gyuho/linux-inspect/schema"
+)
+
+// CPUStatSchema represents 'cpu.stat'.
+// Reference https://www.kernel.org/doc/Documentation/cgroup-v2.txt.
+var CPUStatSchema = schema.RawData{
+	IsYAML: true,
+	Columns: []schema.Column{
+		{Name: "usage_usec", Godoc: "total CPU time consumed by the cgroup", Kind: reflect.Uint64},
+		{Name: "user_usec", Godoc: "CPU time consumed in user mode", Kind: reflect.Uint64},
+		{Name: "system_usec", Godoc: "CPU time consumed in kernel mode", Kind: reflect.Uint64},
+		{Name: "nr_periods", Godoc: "number of enforcement intervals that have elapsed (with 'cpu.max' quota)", Kind: reflect.Uint64},
+		{Name: "nr_throttled", Godoc: "number of times the cgroup has been throttled", Kind: reflect.Uint64},
+		{Name: "throttled_usec", Godoc: "total time the cgroup has been throttled", Kind: reflect.Uint64},
+		{Name: "nr_bursts", Godoc: "number of periods that bursts occurred", Kind: reflect.Uint64},
+		{Name: "burst_usec", Godoc: "total time that bursts exceeded the quota", Kind: reflect.Uint64},
+	},
+	ColumnsToParse: map[string]schema.RawDataType{
+		"usage_usec":     schema.TypeTimeMicroseconds,
+		"user_usec":      schema.TypeTimeMicroseconds,
+		"system_usec":    schema.TypeTimeMicroseconds,
+		"throttled_usec": schema.TypeTimeMicroseconds,
+	},
+}
+
+// MemoryStatSchema represents 'memory.stat', in bytes
+// unless noted otherwise.
+// Reference https://www.kernel.org/doc/Documentation/cgroup-v2.txt.
+var MemoryStatSchema = schema.RawData{
+	IsYAML: true,
+	Columns: []schema.Column{
+		{Name: "anon", Godoc: "amount of memory used in anonymous mappings", Kind: reflect.Uint64},
+		{Name: "file", Godoc: "amount of memory used to cache filesystem data", Kind: reflect.Uint64},
+		{Name: "kernel_stack", Godoc: "amount of memory allocated to kernel stacks", Kind: reflect.Uint64},
+		{Name: "pagetables", Godoc: "amount of memory allocated for page tables", Kind: reflect.Uint64},
+		{Name: "sock", Godoc: "amount of memory used in network transmission buffers", Kind: reflect.Uint64},
+		{Name: "shmem", Godoc: "amount of cached filesystem data that is swap-backed", Kind: reflect.Uint64},
+		{Name: "file_mapped", Godoc: "amount of cached filesystem data mapped with mmap", Kind: reflect.Uint64},
+		{Name: "file_dirty", Godoc: "amount of cached filesystem data that was modified but not yet written back to disk", Kind: reflect.Uint64},
+		{Name: "file_writeback", Godoc: "amount of cached filesystem data that is being written back to disk", Kind: reflect.Uint64},
+		{Name: "anon_thp", Godoc: "amount of memory used in anonymous mappings backed by transparent hugepages", Kind: reflect.Uint64},
+		{Name: "inactive_anon", Godoc: "amount of anonymous memory on the inactive LRU list", Kind: reflect.Uint64},
+		{Name: "active_anon", Godoc: "amount of anonymous memory on the active LRU list", Kind: reflect.Uint64},
+		{Name: "inactive_file", Godoc: "amount of file-backed memory on the inactive LRU list", Kind: reflect.Uint64},
+		{Name: "active_file", Godoc: "amount of file-backed memory on the active LRU list", Kind: reflect.Uint64},
+		{Name: "unevictable", Godoc: "amount of memory that cannot be reclaimed", Kind: reflect.Uint64},
+		{Name: "slab_reclaimable", Godoc: "part of slab that might be reclaimed", Kind: reflect.Uint64},
+		{Name: "slab_unreclaimable", Godoc: "part of slab that cannot be reclaimed on memory pressure", Kind: reflect.Uint64},
+		{Name: "pgfault", Godoc: "total number of page faults", Kind: reflect.Uint64},
+		{Name: "pgmajfault", Godoc: "number of major page faults", Kind: reflect.Uint64},
+	},
+	ColumnsToParse: map[string]schema.RawDataType{
+		"anon":               schema.TypeBytes,
+		"file":               schema.TypeBytes,
+		"kernel_stack":       schema.TypeBytes,
+		"pagetables":         schema.TypeBytes,
+		"sock":               schema.TypeBytes,
+		"shmem":              schema.TypeBytes,
+		"file_mapped":        schema.TypeBytes,
+		"file_dirty":         schema.TypeBytes,
+		"file_writeback":     schema.TypeBytes,
+		"anon_thp":           schema.TypeBytes,
+		"inactive_anon":      schema.TypeBytes,
+		"active_anon":        schema.TypeBytes,
+		"inactive_file":      schema.TypeBytes,
+		"active_file":        schema.TypeBytes,
+		"unevictable":        schema.TypeBytes,
+		"slab_reclaimable":   schema.TypeBytes,
+		"slab_unreclaimable": schema.TypeBytes,
+	},
+}
+
+// MemoryEventsSchema represents 'memory.events'.
+// Reference https://www.kernel.org/doc/Documentation/cgroup-v2.txt.
+var MemoryEventsSchema = schema.RawData{
+	IsYAML: true,
+	Columns: []schema.Column{
+		{Name: "low", Godoc: "number of times the cgroup is reclaimed due to high memory pressure even though its usage is under the low boundary", Kind: reflect.Uint64},
+		{Name: "high", Godoc: "number of times processes of the cgroup are throttled and routed to perform direct memory reclaim because the high memory boundary was exceeded", Kind: reflect.Uint64},
+		{Name: "max", Godoc: "number of times the cgroup's memory usage was about to go over the max boundary", Kind: reflect.Uint64},
+		{Name: "oom", Godoc: "number of times the cgroup's memory usage reached the limit and allocation was about to fail", Kind: reflect.Uint64},
+		{Name: "oom_kill", Godoc: "number of processes belonging to this cgroup killed by any kind of OOM killer", Kind: reflect.Uint64},
+	},
+	ColumnsToParse: map[string]schema.RawDataType{},
+}
+
+// IOStatSchema represents a device line in 'io.stat'.
+// Reference https://www.kernel.org/doc/Documentation/cgroup-v2.txt.
+var IOStatSchema = schema.RawData{
+	IsYAML: false,
+	Columns: []schema.Column{
+		{Name: "device", Godoc: "'major:minor' of the device", Kind: reflect.String},
+		{Name: "rbytes", Godoc: "bytes read", Kind: reflect.Uint64},
+		{Name: "wbytes", Godoc: "bytes written", Kind: reflect.Uint64},
+		{Name: "rios", Godoc: "number of read IOs", Kind: reflect.Uint64},
+		{Name: "wios", Godoc: "number of write IOs", Kind: reflect.Uint64},
+		{Name: "dbytes", Godoc: "bytes discarded", Kind: reflect.Uint64},
+		{Name: "dios", Godoc: "number of discard IOs", Kind: reflect.Uint64},
+	},
+	ColumnsToParse: map[string]schema.RawDataType{
+		"rbytes": schema.TypeBytes,
+		"wbytes": schema.TypeBytes,
+		"dbytes": schema.TypeBytes,
+	},
+}
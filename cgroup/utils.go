@@ -0,0 +1,86 @@
+package cgroup
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gyuho/linux-inspect/pkg/fileutil"
+)
+
+// Max is the 'max' value in limit files (e.g. 'memory.max',
+// 'pids.max'), which means no limit.
+const Max = math.MaxUint64
+
+func readFile(fpath string) ([]byte, error) {
+	f, err := fileutil.OpenToRead(fpath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ioutil.ReadAll(f)
+}
+
+// readUint reads a single value file (e.g. 'memory.current'),
+// where 'max' is returned as 'Max'.
+func readUint(fpath string) (uint64, error) {
+	d, err := readFile(fpath)
+	if err != nil {
+		return 0, err
+	}
+	return parseUint(string(d))
+}
+
+func parseUint(s string) (uint64, error) {
+	s = strings.TrimSpace(s)
+	if s == "max" {
+		return Max, nil
+	}
+	return strconv.ParseUint(s, 10, 64)
+}
+
+// flatKeyedToYAML converts flat keyed files (e.g. 'cpu.stat'),
+// 'usage_usec 123' to 'usage_usec: 123'.
+func flatKeyedToYAML(d []byte) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	scanner := bufio.NewScanner(bytes.NewReader(d))
+	for scanner.Scan() {
+		fs := strings.Fields(scanner.Text())
+		if len(fs) == 0 {
+			continue
+		}
+		if len(fs) != 2 {
+			return nil, fmt.Errorf("unknown flat keyed line %q", scanner.Text())
+		}
+		buf.WriteString(fs[0] + ": " + fs[1] + "\n")
+	}
+	return buf.Bytes(), scanner.Err()
+}
+
+// parseNestedKeyed parses nested keyed lines, such as
+// '8:0 rbytes=1024 wbytes=0' in 'io.stat', into the key
+// and 'key=value' pairs.
+func parseNestedKeyed(txt string) (string, map[string]string, error) {
+	fs := strings.Fields(txt)
+	if len(fs) == 0 {
+		return "", nil, fmt.Errorf("empty nested keyed line")
+	}
+	kvs := make(map[string]string, len(fs)-1)
+	for _, f := range fs[1:] {
+		kv := strings.SplitN(f, "=", 2)
+		if len(kv) != 2 {
+			return "", nil, fmt.Errorf("unknown nested keyed field %q at %q", f, txt)
+		}
+		kvs[kv[0]] = kv[1]
+	}
+	return fs[0], kvs, nil
+}
+
+func humanizeMicroseconds(usec uint64) string {
+	return (time.Duration(usec) * time.Microsecond).String()
+}
@@ -0,0 +1,52 @@
+package cgroup
+
+import "testing"
+
+func TestParseUint(t *testing.T) {
+	tests := []struct {
+		s        string
+		expected uint64
+	}{
+		{"0\n", 0},
+		{"1024\n", 1024},
+		{"max\n", Max},
+	}
+	for i, tt := range tests {
+		v, err := parseUint(tt.s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v != tt.expected {
+			t.Fatalf("#%d: expected %d, got %d", i, tt.expected, v)
+		}
+	}
+	if _, err := parseUint("unknown"); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestFlatKeyedToYAML(t *testing.T) {
+	y, err := flatKeyedToYAML([]byte("usage_usec 123\n\nnr_periods 0\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(y) != "usage_usec: 123\nnr_periods: 0\n" {
+		t.Fatalf("unexpected %q", y)
+	}
+	if _, err = flatKeyedToYAML([]byte("usage_usec 1 2\n")); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestParseNestedKeyed(t *testing.T) {
+	key, kvs, err := parseNestedKeyed("8:0 rbytes=1024 wbytes=0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key != "8:0" || len(kvs) != 2 || kvs["rbytes"] != "1024" || kvs["wbytes"] != "0" {
+		t.Fatalf("unexpected %q %v", key, kvs)
+	}
+	if _, _, err = parseNestedKeyed("8:0 rbytes"); err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -0,0 +1,74 @@
+// generate-cgroup generates 'cgroup' struct based on the schema.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/gyuho/linux-inspect/cgroup"
+	"github.com/gyuho/linux-inspect/pkg/fileutil"
+	"github.com/gyuho/linux-inspect/pkg/timeutil"
+	"github.com/gyuho/linux-inspect/schema"
+)
+
+func main() {
+	wd, err := os.Getwd()
+	if err != nil {
+		panic(err)
+	}
+	exp := filepath.Join(os.Getenv("GOPATH"), "src/github.com/gyuho/linux-inspect")
+	if wd != exp {
+		panic(fmt.Errorf("must be run in repo root %q, but run at %q", exp, wd))
+	}
+
+	buf := new(bytes.Buffer)
+	buf.WriteString(`package cgroup
+
+// updated at ` + timeutil.NowPST().String() + `
+
+`)
+
+	// 'cpu.stat'
+	buf.WriteString(`// CPUStat is 'cpu.stat' in cgroup v2.
+type CPUStat struct {
+`)
+	buf.WriteString(schema.Generate(cgroup.CPUStatSchema))
+	buf.WriteString("}\n\n")
+
+	// 'memory.stat'
+	buf.WriteString(`// MemoryStat is 'memory.stat' in cgroup v2.
+type MemoryStat struct {
+`)
+	buf.WriteString(schema.Generate(cgroup.MemoryStatSchema))
+	buf.WriteString("}\n\n")
+
+	// 'memory.events'
+	buf.WriteString(`// MemoryEvents is 'memory.events' in cgroup v2.
+type MemoryEvents struct {
+`)
+	buf.WriteString(schema.Generate(cgroup.MemoryEventsSchema))
+	buf.WriteString("}\n\n")
+
+	// 'io.stat'
+	buf.WriteString(`// IOStat is a device line in 'io.stat' in cgroup v2.
+type IOStat struct {
+`)
+	buf.WriteString(schema.Generate(cgroup.IOStatSchema))
+	buf.WriteString("}\n\n")
+
+	txt := buf.String()
+	if err := fileutil.ToFile(txt, filepath.Join(os.Getenv("GOPATH"), "src/github.com/gyuho/linux-inspect/cgroup/generated.go")); err != nil {
+		panic(err)
+	}
+	if err := os.Chdir(filepath.Join(os.Getenv("GOPATH"), "src/github.com/gyuho/linux-inspect/cgroup")); err != nil {
+		panic(err)
+	}
+	if err := exec.Command("go", "fmt", "./...").Run(); err != nil {
+		panic(err)
+	}
+
+	fmt.Println("DONE")
+}
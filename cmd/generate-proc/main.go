@@ -39,9 +39,10 @@ type NetDev struct {
 	buf.WriteString(schema.Generate(proc.NetDevSchema))
 	buf.WriteString("}\n\n")
 
-	// '/proc/net/tcp', '/proc/net/tcp6'
+	// '/proc/net/tcp', '/proc/net/tcp6', '/proc/net/udp', '/proc/net/udp6', '/proc/net/raw', '/proc/net/raw6'
 	buf.WriteString(`// NetTCP is '/proc/net/tcp', '/proc/net/tcp6' in Linux.
 // Holds a dump of the TCP socket table.
+// Also used for '/proc/net/udp(6)', '/proc/net/raw(6)'.
 type NetTCP struct {
 `)
 	for _, line := range additionalFieldsNetTCP {
@@ -50,6 +51,14 @@ type NetTCP struct {
 	buf.WriteString(schema.Generate(proc.NetTCPSchema))
 	buf.WriteString("}\n\n")
 
+	// '/proc/net/unix'
+	buf.WriteString(`// NetUnix is '/proc/net/unix' in Linux.
+// Holds a dump of the UNIX domain sockets.
+type NetUnix struct {
+`)
+	buf.WriteString(schema.Generate(proc.NetUnixSchema))
+	buf.WriteString("}\n\n")
+
 	// '/proc/loadavg'
 	buf.WriteString(`// LoadAvg is '/proc/loadavg' in Linux.
 type LoadAvg struct {
@@ -71,6 +80,91 @@ type DiskStat struct {
 	buf.WriteString(schema.Generate(proc.DiskStatSchema))
 	buf.WriteString("}\n\n")
 
+	// '/proc/stat'
+	buf.WriteString(`// CPUStat is a 'cpu' line in '/proc/stat' in Linux.
+type CPUStat struct {
+`)
+	buf.WriteString(schema.Generate(proc.CPUStatSchema))
+	buf.WriteString("}\n\n")
+
+	buf.WriteString(`// SystemStat is '/proc/stat' in Linux.
+// It contains kernel/system statistics.
+type SystemStat struct {
+`)
+	for _, line := range additionalFieldsSystemStat {
+		buf.WriteString(fmt.Sprintf("\t%s\n", line))
+	}
+	buf.WriteString(schema.Generate(proc.SystemStatSchema))
+	buf.WriteString("}\n\n")
+
+	// '/proc/meminfo'
+	buf.WriteString(`// Meminfo is '/proc/meminfo' in Linux.
+// It reports statistics about memory usage on the system.
+type Meminfo struct {
+`)
+	buf.WriteString(schema.Generate(proc.MeminfoSchema))
+	buf.WriteString("}\n\n")
+
+	// '/proc/$PID/smaps'
+	buf.WriteString(`// Smaps is a mapping in '/proc/$PID/smaps' in Linux,
+// or the sum of all mappings in '/proc/$PID/smaps_rollup'.
+type Smaps struct {
+`)
+	buf.WriteString(schema.Generate(proc.SmapsSchema))
+	buf.WriteString("}\n\n")
+
+	// '/proc/pressure/cpu', '/proc/pressure/memory', '/proc/pressure/io'
+	buf.WriteString(`// PressureStat is a 'some' or 'full' line in '/proc/pressure/*' in Linux.
+type PressureStat struct {
+`)
+	buf.WriteString(schema.Generate(proc.PressureStatSchema))
+	buf.WriteString("}\n\n")
+
+	// '/proc/net/snmp'
+	buf.WriteString(`// NetSnmpIP is the 'Ip' section in '/proc/net/snmp' in Linux.
+type NetSnmpIP struct {
+`)
+	buf.WriteString(schema.Generate(proc.NetSnmpIPSchema))
+	buf.WriteString("}\n\n")
+
+	buf.WriteString(`// NetSnmpICMP is the 'Icmp' section in '/proc/net/snmp' in Linux.
+type NetSnmpICMP struct {
+`)
+	buf.WriteString(schema.Generate(proc.NetSnmpICMPSchema))
+	buf.WriteString("}\n\n")
+
+	buf.WriteString(`// NetSnmpTCP is the 'Tcp' section in '/proc/net/snmp' in Linux.
+type NetSnmpTCP struct {
+`)
+	buf.WriteString(schema.Generate(proc.NetSnmpTCPSchema))
+	buf.WriteString("}\n\n")
+
+	buf.WriteString(`// NetSnmpUDP is the 'Udp' or 'UdpLite' section in '/proc/net/snmp' in Linux.
+type NetSnmpUDP struct {
+`)
+	buf.WriteString(schema.Generate(proc.NetSnmpUDPSchema))
+	buf.WriteString("}\n\n")
+
+	// '/proc/net/snmp6'
+	buf.WriteString(`// NetSnmp6 is '/proc/net/snmp6' in Linux.
+type NetSnmp6 struct {
+`)
+	buf.WriteString(schema.Generate(proc.NetSnmp6Schema))
+	buf.WriteString("}\n\n")
+
+	// '/proc/net/netstat'
+	buf.WriteString(`// NetstatTCPExt is the 'TcpExt' section in '/proc/net/netstat' in Linux.
+type NetstatTCPExt struct {
+`)
+	buf.WriteString(schema.Generate(proc.NetstatTCPExtSchema))
+	buf.WriteString("}\n\n")
+
+	buf.WriteString(`// NetstatIPExt is the 'IpExt' section in '/proc/net/netstat' in Linux.
+type NetstatIPExt struct {
+`)
+	buf.WriteString(schema.Generate(proc.NetstatIPExtSchema))
+	buf.WriteString("}\n\n")
+
 	// '/proc/$PID/io'
 	buf.WriteString(`// IO is '/proc/$PID/io' in Linux.
 type IO struct {
@@ -109,3 +203,10 @@ type Status struct {
 var additionalFieldsNetTCP = [...]string{
 	"Type string `column:\"type\"`",
 }
+
+var additionalFieldsSystemStat = [...]string{
+	"// CPU is the aggregate of all CPUs.",
+	"CPU CPUStat `column:\"cpu\"`",
+	"// CPUs is the per-CPU statistics.",
+	"CPUs []CPUStat `column:\"cpus\"`",
+}
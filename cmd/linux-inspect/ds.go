@@ -1,12 +1,10 @@
 package main
 
 import (
-	"fmt"
-	"os"
+	"time"
 
 	"github.com/gyuho/linux-inspect/inspect"
 
-	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 )
 
@@ -16,24 +14,45 @@ var (
 		Short: "Inspects '/proc/diskstats'",
 		RunE:  dsCommandFunc,
 	}
+	dsCmdWatchFlag watchFlags
 )
 
+func init() {
+	dsCmdWatchFlag.register(dsCommand)
+}
+
 func dsCommandFunc(cmd *cobra.Command, args []string) error {
-	color.Set(color.FgMagenta)
-	fmt.Fprintf(os.Stdout, "\n'ds' to inspect '/proc/diskstats'\n\n")
-	color.Unset()
+	if !dsCmdWatchFlag.watch {
+		printBanner("\n'ds' to inspect '/proc/diskstats'\n\n")
+
+		ds, err := inspect.GetDS()
+		if err != nil {
+			return err
+		}
+		hd, rows := inspect.ConvertDS(ds...)
+		return printEntries(ds, hd, rows, inspect.StringDS)
+	}
 
-	ds, err := inspect.GetDS()
+	// show per-interval rates instead of cumulative counters
+	prev, err := inspect.GetDS()
 	if err != nil {
 		return err
 	}
-	hd, rows := inspect.ConvertDS(ds...)
-	txt := inspect.StringDS(hd, rows, -1)
-	fmt.Print(txt)
-
-	color.Set(color.FgGreen)
-	fmt.Fprintf(os.Stdout, "\nDONE!\n")
-	color.Unset()
-
-	return nil
+	prevTime := time.Now()
+	time.Sleep(dsCmdWatchFlag.interval)
+
+	return dsCmdWatchFlag.run(func() error {
+		printBanner("\n'ds' to inspect '/proc/diskstats' (per second)\n\n")
+
+		cur, err := inspect.GetDS()
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+		rs := inspect.GetDSRate(prev, cur, now.Sub(prevTime))
+		prev, prevTime = cur, now
+
+		hd, rows := inspect.ConvertDSRate(rs...)
+		return printEntries(rs, hd, rows, inspect.StringDSRate)
+	})
 }
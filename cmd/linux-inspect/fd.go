@@ -0,0 +1,92 @@
+package main
+
+import (
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/gyuho/linux-inspect/inspect"
+	"github.com/gyuho/linux-inspect/proc"
+
+	"github.com/spf13/cobra"
+)
+
+type fdFlags struct {
+	limit int
+
+	program string
+	pid     int64
+
+	path    string
+	tp      string
+	deleted bool
+}
+
+var (
+	fdCommand = &cobra.Command{
+		Use:   "fd",
+		Short: "Inspects '/proc/$PID/fd,fdinfo'",
+		RunE:  fdCommandFunc,
+	}
+	fdCmdFlag fdFlags
+)
+
+func init() {
+	fdCommand.PersistentFlags().IntVarP(&fdCmdFlag.limit, "limit", "l", -1, "Limit the number results to return.")
+
+	fdCommand.PersistentFlags().StringVarP(&fdCmdFlag.program, "program", "s", "", "Specify the program name.")
+	fdCommand.PersistentFlags().Int64VarP(&fdCmdFlag.pid, "pid", "p", -1, "Specify the PID (all processes if not specified).")
+
+	fdCommand.PersistentFlags().StringVar(&fdCmdFlag.path, "path", "", "Specify the target path prefix to filter by.")
+	fdCommand.PersistentFlags().StringVar(&fdCmdFlag.tp, "type", "", "Specify the type to filter by ('file', 'deleted', 'dir', 'device', 'socket', 'pipe', 'eventfd', 'anon_inode', 'other').")
+	fdCommand.PersistentFlags().BoolVar(&fdCmdFlag.deleted, "deleted", false, "'true' to list deleted-but-open files that are holding disk space, by size.")
+}
+
+func fdCommandFunc(cmd *cobra.Command, args []string) error {
+	if fdCmdFlag.deleted {
+		fdCmdFlag.tp = inspect.FDTypeDeleted
+	}
+	printBanner("\n'fd' to inspect '/proc/$PID/fd,fdinfo'\n\n")
+
+	pids := []int64{fdCmdFlag.pid}
+	if fdCmdFlag.pid < 1 {
+		var err error
+		if pids, err = proc.ListPIDs(); err != nil {
+			return err
+		}
+	}
+
+	var fes []inspect.FDEntry
+	for _, pid := range pids {
+		es, err := inspect.GetFDs(pid)
+		if err != nil {
+			if fdCmdFlag.pid > 0 {
+				return err
+			}
+			// process may have exited, or needs root permission
+			log.Printf("GetFDs error %v for PID %d", err, pid)
+			continue
+		}
+		for _, fe := range es {
+			if fdCmdFlag.program != "" && !strings.HasSuffix(fe.Program, fdCmdFlag.program) {
+				continue
+			}
+			if fdCmdFlag.path != "" && !strings.HasPrefix(fe.Target, fdCmdFlag.path) {
+				continue
+			}
+			if fdCmdFlag.tp != "" && fe.Type != fdCmdFlag.tp {
+				continue
+			}
+			fes = append(fes, fe)
+		}
+	}
+	if fdCmdFlag.deleted {
+		sort.SliceStable(fes, func(i, j int) bool { return fes[i].SizeNum > fes[j].SizeNum })
+	}
+	if fdCmdFlag.limit > 0 && len(fes) > fdCmdFlag.limit {
+		fes = fes[:fdCmdFlag.limit]
+	}
+
+	hd, rows := inspect.ConvertFDs(fes...)
+	return printEntries(fes, hd, rows, inspect.StringFDs)
+}
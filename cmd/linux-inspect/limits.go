@@ -0,0 +1,78 @@
+package main
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/gyuho/linux-inspect/inspect"
+	"github.com/gyuho/linux-inspect/proc"
+
+	"github.com/spf13/cobra"
+)
+
+type limitsFlags struct {
+	limit int
+
+	program string
+	pid     int64
+
+	percent float64
+}
+
+var (
+	limitsCommand = &cobra.Command{
+		Use:   "limits",
+		Short: "Inspects '/proc/$PID/limits'",
+		RunE:  limitsCommandFunc,
+	}
+	limitsCmdFlag limitsFlags
+)
+
+func init() {
+	limitsCommand.PersistentFlags().IntVarP(&limitsCmdFlag.limit, "limit", "l", -1, "Limit the number results to return.")
+
+	limitsCommand.PersistentFlags().StringVarP(&limitsCmdFlag.program, "program", "s", "", "Specify the program name.")
+	limitsCommand.PersistentFlags().Int64VarP(&limitsCmdFlag.pid, "pid", "p", -1, "Specify the PID (all processes if not specified).")
+
+	limitsCommand.PersistentFlags().Float64Var(&limitsCmdFlag.percent, "percent", 80, "Specify the usage percentage of the soft limit to flag (0 to list all).")
+}
+
+func limitsCommandFunc(cmd *cobra.Command, args []string) error {
+	printBanner("\n'limits' to inspect '/proc/$PID/limits' (usage >= %.2f %%)\n\n", limitsCmdFlag.percent)
+
+	var (
+		es  []inspect.LimitsEntry
+		err error
+	)
+	if limitsCmdFlag.pid > 0 {
+		es, err = inspect.GetLimits(limitsCmdFlag.pid)
+	} else {
+		var pids []int64
+		if pids, err = proc.ListPIDs(); err != nil {
+			return err
+		}
+		es, err = inspect.GetLimitsByPIDs(pids)
+	}
+	if err != nil {
+		return err
+	}
+
+	var les []inspect.LimitsEntry
+	for _, le := range es {
+		if limitsCmdFlag.program != "" && !strings.HasSuffix(le.Program, limitsCmdFlag.program) {
+			continue
+		}
+		if le.PercentNum < limitsCmdFlag.percent {
+			continue
+		}
+		les = append(les, le)
+	}
+	// closest to the limits first
+	sort.SliceStable(les, func(i, j int) bool { return les[i].PercentNum > les[j].PercentNum })
+	if limitsCmdFlag.limit > 0 && len(les) > limitsCmdFlag.limit {
+		les = les[:limitsCmdFlag.limit]
+	}
+
+	hd, rows := inspect.ConvertLimits(les...)
+	return printEntries(les, hd, rows, inspect.StringLimits)
+}
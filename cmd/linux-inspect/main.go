@@ -5,9 +5,17 @@
 //
 //	Available Commands:
 //	ds          Inspects '/proc/diskstats'
-//	ns          Inspects '/proc/net/dev'
+//	fd          Inspects '/proc/$PID/fd,fdinfo'
+//	mem         Inspects '/proc/meminfo'
+//	limits      Inspects '/proc/$PID/limits'
+//	maps        Inspects '/proc/$PID/maps'
+//	ns          Inspects '/proc/net/dev' and '/sys/class/net'
 //	ps          Inspects '/proc/$PID/stat,status'
-//	ss          Inspects '/proc/net/tcp,tcp6'
+//	psi         Inspects '/proc/pressure/cpu,memory,io'
+//	pstree      Inspects process trees from '/proc/$PID/stat,status'
+//	route       Inspects '/proc/net/route,ipv6_route'
+//	serve       Serves Prometheus metrics at '/metrics'
+//	ss          Inspects '/proc/net/tcp,tcp6,udp,udp6,raw,raw6,unix'
 //
 package main
 
@@ -28,8 +36,16 @@ var (
 
 func init() {
 	command.AddCommand(dsCommand)
+	command.AddCommand(fdCommand)
+	command.AddCommand(limitsCommand)
+	command.AddCommand(mapsCommand)
+	command.AddCommand(memCommand)
 	command.AddCommand(nsCommand)
 	command.AddCommand(psCommand)
+	command.AddCommand(psiCommand)
+	command.AddCommand(pstreeCommand)
+	command.AddCommand(routeCommand)
+	command.AddCommand(serveCommand)
 	command.AddCommand(ssCommand)
 }
 
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/gyuho/linux-inspect/inspect"
+
+	"github.com/spf13/cobra"
+)
+
+type mapsFlags struct {
+	limit int
+	pid   int64
+}
+
+var (
+	mapsCommand = &cobra.Command{
+		Use:   "maps",
+		Short: "Inspects '/proc/$PID/maps'",
+		RunE:  mapsCommandFunc,
+	}
+	mapsCmdFlag mapsFlags
+)
+
+func init() {
+	mapsCommand.PersistentFlags().IntVarP(&mapsCmdFlag.limit, "limit", "l", -1, "Limit the number results to return.")
+	mapsCommand.PersistentFlags().Int64VarP(&mapsCmdFlag.pid, "pid", "p", -1, "Specify the PID.")
+}
+
+func mapsCommandFunc(cmd *cobra.Command, args []string) error {
+	if mapsCmdFlag.pid < 1 {
+		return fmt.Errorf("'maps' requires '--pid'")
+	}
+	printBanner("\n'maps' to inspect '/proc/%d/maps'\n\n", mapsCmdFlag.pid)
+
+	mss, err := inspect.GetMaps(mapsCmdFlag.pid, inspect.WithTopLimit(mapsCmdFlag.limit))
+	if err != nil {
+		return err
+	}
+	hd, rows := inspect.ConvertMaps(mss...)
+	return printEntries(mss, hd, rows, inspect.StringMaps)
+}
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"github.com/gyuho/linux-inspect/inspect"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	memCommand = &cobra.Command{
+		Use:   "mem",
+		Short: "Inspects '/proc/meminfo'",
+		RunE:  memCommandFunc,
+	}
+)
+
+func memCommandFunc(cmd *cobra.Command, args []string) error {
+	printBanner("\n'mem' to inspect '/proc/meminfo'\n\n")
+
+	m, err := inspect.GetMem()
+	if err != nil {
+		return err
+	}
+	hd, rows := inspect.ConvertMem(m)
+	return printEntries(m, hd, rows, inspect.StringMem)
+}
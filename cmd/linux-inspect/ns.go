@@ -2,38 +2,76 @@ package main
 
 import (
 	"fmt"
-	"os"
+	"time"
 
 	"github.com/gyuho/linux-inspect/inspect"
 
-	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 )
 
+type nsFlags struct {
+	pid              int64
+	allNetNamespaces bool
+}
+
 var (
 	nsCommand = &cobra.Command{
 		Use:   "ns",
-		Short: "Inspects '/proc/net/dev'",
+		Short: "Inspects '/proc/net/dev' and '/sys/class/net'",
 		RunE:  nsCommandFunc,
 	}
+	nsCmdFlag      = nsFlags{}
+	nsCmdWatchFlag watchFlags
 )
 
+func init() {
+	nsCommand.PersistentFlags().Int64VarP(&nsCmdFlag.pid, "pid", "p", -1, "Specify the PID to read '/proc/$PID/net/dev' in its network namespace.")
+	nsCommand.PersistentFlags().BoolVar(&nsCmdFlag.allNetNamespaces, "all-net-namespaces", false, "Read '/proc/$PID/net/dev' once per network namespace of all processes.")
+	nsCmdWatchFlag.register(nsCommand)
+}
+
 func nsCommandFunc(cmd *cobra.Command, args []string) error {
-	color.Set(color.FgMagenta)
-	fmt.Fprintf(os.Stdout, "\n'ns' to inspect '/proc/net/dev'\n\n")
-	color.Unset()
+	var opts []inspect.OpFunc
+	switch {
+	case nsCmdFlag.pid > 0 && nsCmdFlag.allNetNamespaces:
+		return fmt.Errorf("'--pid' and '--all-net-namespaces' can't be used together")
+	case nsCmdFlag.pid > 0:
+		opts = append(opts, inspect.WithPID(nsCmdFlag.pid))
+	case nsCmdFlag.allNetNamespaces:
+		opts = append(opts, inspect.WithAllNetNamespaces())
+	}
 
-	ns, err := inspect.GetNS()
+	if !nsCmdWatchFlag.watch {
+		printBanner("\n'ns' to inspect '/proc/net/dev' and '/sys/class/net'\n\n")
+
+		ns, err := inspect.GetNS(opts...)
+		if err != nil {
+			return err
+		}
+		hd, rows := inspect.ConvertNS(ns...)
+		return printEntries(ns, hd, rows, inspect.StringNS)
+	}
+
+	// show per-interval rates instead of cumulative counters
+	prev, err := inspect.GetNS(opts...)
 	if err != nil {
 		return err
 	}
-	hd, rows := inspect.ConvertNS(ns...)
-	txt := inspect.StringNS(hd, rows, -1)
-	fmt.Print(txt)
+	prevTime := time.Now()
+	time.Sleep(nsCmdWatchFlag.interval)
+
+	return nsCmdWatchFlag.run(func() error {
+		printBanner("\n'ns' to inspect '/proc/net/dev' and '/sys/class/net' (per second)\n\n")
 
-	color.Set(color.FgGreen)
-	fmt.Fprintf(os.Stdout, "\nDONE!\n")
-	color.Unset()
+		cur, err := inspect.GetNS(opts...)
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+		rs := inspect.GetNSRate(prev, cur, now.Sub(prevTime))
+		prev, prevTime = cur, now
 
-	return nil
+		hd, rows := inspect.ConvertNSRate(rs...)
+		return printEntries(rs, hd, rows, inspect.StringNSRate)
+	})
 }
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	yaml "gopkg.in/yaml.v2"
+)
+
+const (
+	outputTable  = "table"
+	outputJSON   = "json"
+	outputNDJSON = "ndjson"
+	outputCSV    = "csv"
+	outputYAML   = "yaml"
+)
+
+var outputFormat string
+
+func init() {
+	command.PersistentFlags().StringVarP(&outputFormat, "output", "o", outputTable, "Specify the output format ('table', 'json', 'ndjson', 'csv', 'yaml').")
+	command.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		switch outputFormat {
+		case outputTable, outputJSON, outputNDJSON, outputCSV, outputYAML:
+			return nil
+		default:
+			return fmt.Errorf("unknown output format %q", outputFormat)
+		}
+	}
+}
+
+// printBanner prints the colored banner, only in table output.
+func printBanner(format string, a ...interface{}) {
+	if outputFormat != outputTable {
+		return
+	}
+	color.Set(color.FgMagenta)
+	fmt.Fprintf(os.Stdout, format, a...)
+	color.Unset()
+}
+
+// printEntries prints the entries in the output format.
+// 'table' and 'csv' print the header and rows from 'Convert*',
+// and the others print the entry structs as they are.
+func printEntries(entries interface{}, hd []string, rows [][]string, tableFunc func([]string, [][]string, int) string) error {
+	switch outputFormat {
+	case outputTable:
+		fmt.Print(tableFunc(hd, rows, -1))
+
+		color.Set(color.FgGreen)
+		fmt.Fprintf(os.Stdout, "\nDONE!\n")
+		color.Unset()
+		return nil
+
+	case outputJSON:
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(entries)
+
+	case outputNDJSON:
+		// one entry per line
+		enc := json.NewEncoder(os.Stdout)
+		v := reflect.ValueOf(entries)
+		if v.Kind() != reflect.Slice {
+			return enc.Encode(entries)
+		}
+		for i := 0; i < v.Len(); i++ {
+			if err := enc.Encode(v.Index(i).Interface()); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case outputCSV:
+		wr := csv.NewWriter(os.Stdout)
+		if err := wr.Write(hd); err != nil {
+			return err
+		}
+		return wr.WriteAll(rows)
+
+	case outputYAML:
+		b, err := yaml.Marshal(entries)
+		if err != nil {
+			return err
+		}
+		_, err = os.Stdout.Write(b)
+		return err
+
+	default:
+		return fmt.Errorf("unknown output format %q", outputFormat)
+	}
+}
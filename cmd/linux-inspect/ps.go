@@ -2,12 +2,12 @@ package main
 
 import (
 	"fmt"
-	"os"
+	"strings"
 
 	"github.com/gyuho/linux-inspect/inspect"
+	"github.com/gyuho/linux-inspect/proc"
 	"github.com/gyuho/linux-inspect/top"
 
-	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 )
 
@@ -17,49 +17,140 @@ type psFlags struct {
 
 	program string
 	pid     int64
+
+	sortBy  string
+	smaps   bool
+	cgroup  bool
+	threads bool
+
+	watch watchFlags
 }
 
 var (
 	psCommand = &cobra.Command{
 		Use:   "ps",
-		Short: "Inspects '/proc/$PID/status', 'top' command output",
+		Short: "Inspects '/proc/$PID/stat,status'",
 		RunE:  psCommandFunc,
 	}
 	psCmdFlag psFlags
 )
 
 func init() {
-	psCommand.PersistentFlags().StringVarP(&psCmdFlag.topExecPath, "top-exec", "t", "", "Specify the top command path.")
+	psCommand.PersistentFlags().StringVarP(&psCmdFlag.topExecPath, "top-exec", "t", "", "Specify the top command path (CPU usage is sampled from '/proc' if empty).")
 	psCommand.PersistentFlags().IntVarP(&psCmdFlag.limit, "limit", "l", 5, "Limit the number results to return.")
 
 	psCommand.PersistentFlags().StringVarP(&psCmdFlag.program, "program", "s", "", "Specify the program name.")
 	psCommand.PersistentFlags().Int64VarP(&psCmdFlag.pid, "pid", "p", -1, "Specify the PID.")
+	psCommand.PersistentFlags().StringVar(&psCmdFlag.sortBy, "sort", "", "Specify the sort key ('cpu', 'vmrss', 'vmsize', 'pss', 'uss'), in descending order.")
+	psCommand.PersistentFlags().BoolVar(&psCmdFlag.smaps, "smaps", false, "'true' to read PSS and USS from '/proc/$PID/smaps_rollup' (implied by '--sort pss' or '--sort uss').")
+	psCommand.PersistentFlags().BoolVar(&psCmdFlag.cgroup, "cgroup", false, "'true' to group processes by container (or cgroup path if not in a container).")
+	psCommand.PersistentFlags().BoolVarP(&psCmdFlag.threads, "threads", "T", false, "'true' to inspect the threads in '/proc/$PID/task/$TID' of the PID or program.")
+	psCmdFlag.watch.register(psCommand)
 }
 
 func psCommandFunc(cmd *cobra.Command, args []string) error {
-	color.Set(color.FgMagenta)
-	fmt.Fprintf(os.Stdout, "\n'ps' to inspect '/proc/$PID/status', 'top' command outpu\n\n")
-	color.Unset()
-
-	if psCmdFlag.topExecPath == "" {
-		psCmdFlag.topExecPath = top.DefaultExecPath
+	if psCmdFlag.threads {
+		return psThreadsCommandFunc()
 	}
-	pss, err := inspect.GetPS(
+
+	opts := []inspect.OpFunc{
 		inspect.WithProgram(psCmdFlag.program),
 		inspect.WithPID(psCmdFlag.pid),
 		inspect.WithTopExecPath(psCmdFlag.topExecPath),
 		inspect.WithTopLimit(psCmdFlag.limit),
-	)
-	if err != nil {
-		return err
+		inspect.WithSortBy(psCmdFlag.sortBy),
+		inspect.WithSmaps(psCmdFlag.smaps),
 	}
-	hd, rows := inspect.ConvertPS(pss...)
-	txt := inspect.StringPS(hd, rows, -1)
-	fmt.Print(txt)
+	if psCmdFlag.watch.watch {
+		// reuse one 'top' stream or CPU sampler across refreshes
+		if psCmdFlag.topExecPath != "" {
+			cfg := &top.Config{
+				Exec:           psCmdFlag.topExecPath,
+				IntervalSecond: psCmdFlag.watch.interval.Seconds(),
+				PID:            psCmdFlag.pid,
+			}
+			str, err := cfg.StartStream()
+			if err != nil {
+				return err
+			}
+			defer str.Stop()
+			opts = append(opts, inspect.WithTopStream(str))
+		} else {
+			opts = append(opts, inspect.WithCPUSampler(inspect.NewCPUSampler(psCmdFlag.watch.interval)))
+		}
+	}
+
+	return psCmdFlag.watch.run(func() error {
+		printBanner("\n'ps' to inspect '/proc/$PID/stat,status'\n\n")
 
-	color.Set(color.FgGreen)
-	fmt.Fprintf(os.Stdout, "\nDONE!\n")
-	color.Unset()
+		pss, err := inspect.GetPS(opts...)
+		if err != nil {
+			return err
+		}
+		hd, rows := inspect.ConvertPS(pss...)
+		if psCmdFlag.sortBy != "" {
+			if err = inspect.SortPS(rows, psCmdFlag.sortBy); err != nil {
+				return err
+			}
+		}
+		if psCmdFlag.cgroup {
+			inspect.GroupPSByContainer(rows)
+		}
+		return printEntries(pss, hd, rows, inspect.StringPS)
+	})
+}
+
+func psThreadsCommandFunc() error {
+	if psCmdFlag.pid < 1 && psCmdFlag.program == "" {
+		return fmt.Errorf("'--threads' requires '--pid' or '--program'")
+	}
 
-	return nil
+	// reused across refreshes, so that CPU usage is
+	// computed since the previous refresh
+	sampler := inspect.NewCPUSampler(inspect.DefaultCPUSampleInterval)
+	if psCmdFlag.watch.watch {
+		sampler = inspect.NewCPUSampler(psCmdFlag.watch.interval)
+	}
+
+	return psCmdFlag.watch.run(func() error {
+		printBanner("\n'ps --threads' to inspect '/proc/$PID/task/$TID/stat,status'\n\n")
+
+		pids := []int64{psCmdFlag.pid}
+		if psCmdFlag.pid < 1 {
+			var err error
+			if pids, err = listPIDsByProgram(psCmdFlag.program); err != nil {
+				return err
+			}
+		}
+
+		// sample the threads of all matching processes at once,
+		// sorted by CPU usage across all processes
+		ths, err := inspect.GetThreadsByPIDs(pids, inspect.WithCPUSampler(sampler), inspect.WithTopLimit(psCmdFlag.limit))
+		if err != nil {
+			return err
+		}
+		hd, rows := inspect.ConvertThreads(ths...)
+		return printEntries(ths, hd, rows, inspect.StringThreads)
+	})
+}
+
+// listPIDsByProgram finds the PIDs whose command name ends with
+// the program name, as in 'inspect.WithProgram', only reading
+// '/proc/$PID/stat'. Processes that have exited are skipped.
+func listPIDsByProgram(program string) ([]int64, error) {
+	all, err := proc.ListPIDs()
+	if err != nil {
+		return nil, err
+	}
+	var pids []int64
+	for _, pid := range all {
+		stat, err := proc.GetStatByPID(pid)
+		if err != nil {
+			continue
+		}
+		if strings.HasSuffix(stat.Comm, program) {
+			pids = append(pids, pid)
+		}
+	}
+	return pids, nil
 }
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"github.com/gyuho/linux-inspect/inspect"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	psiCommand = &cobra.Command{
+		Use:   "psi",
+		Short: "Inspects '/proc/pressure/cpu,memory,io'",
+		RunE:  psiCommandFunc,
+	}
+)
+
+func psiCommandFunc(cmd *cobra.Command, args []string) error {
+	printBanner("\n'psi' to inspect '/proc/pressure/cpu,memory,io'\n\n")
+
+	es, err := inspect.GetPSI()
+	if err != nil {
+		return err
+	}
+	hd, rows := inspect.ConvertPSI(es...)
+	return printEntries(es, hd, rows, inspect.StringPSI)
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"github.com/gyuho/linux-inspect/inspect"
+
+	"github.com/spf13/cobra"
+)
+
+type pstreeFlags struct {
+	topExecPath string
+	limit       int
+
+	program string
+	pid     int64
+}
+
+var (
+	pstreeCommand = &cobra.Command{
+		Use:   "pstree",
+		Short: "Inspects process trees from '/proc/$PID/stat,status'",
+		RunE:  pstreeCommandFunc,
+	}
+	pstreeCmdFlag pstreeFlags
+)
+
+func init() {
+	pstreeCommand.PersistentFlags().StringVarP(&pstreeCmdFlag.topExecPath, "top-exec", "t", "", "Specify the top command path (CPU usage is sampled from '/proc' if empty).")
+	pstreeCommand.PersistentFlags().IntVarP(&pstreeCmdFlag.limit, "limit", "l", -1, "Limit the number of trees to return.")
+
+	pstreeCommand.PersistentFlags().StringVarP(&pstreeCmdFlag.program, "program", "s", "", "Specify the program name to root the trees at.")
+	pstreeCommand.PersistentFlags().Int64VarP(&pstreeCmdFlag.pid, "pid", "p", -1, "Specify the PID to root the tree at.")
+}
+
+func pstreeCommandFunc(cmd *cobra.Command, args []string) error {
+	printBanner("\n'pstree' to inspect process trees from '/proc/$PID/stat,status'\n\n")
+
+	opts := []inspect.OpFunc{
+		inspect.WithPID(pstreeCmdFlag.pid),
+		inspect.WithTopExecPath(pstreeCmdFlag.topExecPath),
+		inspect.WithTopLimit(pstreeCmdFlag.limit),
+	}
+	if pstreeCmdFlag.program != "" {
+		opts = append(opts, inspect.WithProgram(pstreeCmdFlag.program))
+	}
+	ts, err := inspect.GetProcessTree(opts...)
+	if err != nil {
+		return err
+	}
+	hd, rows := inspect.ConvertProcessTree(ts...)
+	return printEntries(ts, hd, rows, inspect.StringProcessTree)
+}
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"github.com/gyuho/linux-inspect/inspect"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	routeCommand = &cobra.Command{
+		Use:   "route",
+		Short: "Inspects '/proc/net/route,ipv6_route'",
+		RunE:  routeCommandFunc,
+	}
+)
+
+func routeCommandFunc(cmd *cobra.Command, args []string) error {
+	printBanner("\n'route' to inspect '/proc/net/route,ipv6_route'\n\n")
+
+	es, err := inspect.GetRoute()
+	if err != nil {
+		return err
+	}
+	hd, rows := inspect.ConvertRoute(es...)
+	return printEntries(es, hd, rows, inspect.StringRoute)
+}
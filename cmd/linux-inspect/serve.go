@@ -0,0 +1,58 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/gyuho/linux-inspect/inspect"
+
+	"github.com/spf13/cobra"
+)
+
+type serveFlags struct {
+	listen string
+
+	topExecPath string
+	program     string
+	pid         int64
+
+	diskDevice       string
+	networkInterface string
+}
+
+var (
+	serveCommand = &cobra.Command{
+		Use:   "serve",
+		Short: "Serves Prometheus metrics at '/metrics'",
+		RunE:  serveCommandFunc,
+	}
+	serveCmdFlag serveFlags
+)
+
+func init() {
+	serveCommand.PersistentFlags().StringVar(&serveCmdFlag.listen, "listen", ":9299", "Specify the address to listen on.")
+
+	serveCommand.PersistentFlags().StringVarP(&serveCmdFlag.topExecPath, "top-exec", "t", "", "Specify the top command path (CPU usage is sampled from '/proc' if empty).")
+	serveCommand.PersistentFlags().StringVarP(&serveCmdFlag.program, "program", "s", "", "Specify the program name.")
+	serveCommand.PersistentFlags().Int64VarP(&serveCmdFlag.pid, "pid", "p", -1, "Specify the PID.")
+
+	serveCommand.PersistentFlags().StringVarP(&serveCmdFlag.diskDevice, "disk-device", "d", "", "Specify the disk device.")
+	serveCommand.PersistentFlags().StringVarP(&serveCmdFlag.networkInterface, "network-interface", "n", "", "Specify the network interface.")
+}
+
+func serveCommandFunc(cmd *cobra.Command, args []string) error {
+	opts := []inspect.OpFunc{
+		inspect.WithTopExecPath(serveCmdFlag.topExecPath),
+		inspect.WithPID(serveCmdFlag.pid),
+	}
+	if serveCmdFlag.program != "" {
+		opts = append(opts, inspect.WithProgram(serveCmdFlag.program))
+	}
+	c := inspect.NewCollector(serveCmdFlag.diskDevice, serveCmdFlag.networkInterface, opts...)
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", c)
+
+	printBanner("\n'serve' to expose metrics at 'http://%s/metrics'\n\n", serveCmdFlag.listen)
+
+	return http.ListenAndServe(serveCmdFlag.listen, mux)
+}
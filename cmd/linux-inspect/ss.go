@@ -3,10 +3,11 @@ package main
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/gyuho/linux-inspect/inspect"
+	"github.com/gyuho/linux-inspect/proc"
 
-	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 )
 
@@ -17,12 +18,17 @@ type ssFlags struct {
 	program   string
 	protocol  string
 	localPort int64
+	unowned   bool
+	netlink   bool
+	summary   bool
+
+	watch watchFlags
 }
 
 var (
 	ssCommand = &cobra.Command{
 		Use:   "ss",
-		Short: "Inspects '/proc/net/tcp,tcp6'",
+		Short: "Inspects '/proc/net/tcp,tcp6,udp,udp6,raw,raw6,unix'",
 		RunE:  ssCommandFunc,
 	}
 	ssCmdFlag = ssFlags{}
@@ -32,40 +38,102 @@ func init() {
 	ssCommand.PersistentFlags().StringVarP(&ssCmdFlag.topExecPath, "top-exec", "t", "", "Specify the top command path.")
 	ssCommand.PersistentFlags().IntVarP(&ssCmdFlag.limit, "limit", "l", 5, "Limit the number results to return.")
 
-	ssCommand.PersistentFlags().StringVarP(&ssCmdFlag.protocol, "protocol", "c", "tcp", "Specify the protocol ('tcp' or 'tcp6').")
+	ssCommand.PersistentFlags().StringVarP(&ssCmdFlag.protocol, "protocol", "c", "tcp", "Specify the protocols, separated by comma ('tcp', 'tcp6', 'udp', 'udp6', 'raw', 'raw6', 'unix').")
 	ssCommand.PersistentFlags().StringVarP(&ssCmdFlag.program, "program", "s", "", "Specify the program name.")
 	ssCommand.PersistentFlags().Int64VarP(&ssCmdFlag.localPort, "local-port", "p", -1, "Specify the local port.")
+	ssCommand.PersistentFlags().BoolVarP(&ssCmdFlag.unowned, "unowned", "u", false, "Include sockets with no owning process (e.g. TIME_WAIT).")
+	ssCommand.PersistentFlags().BoolVarP(&ssCmdFlag.netlink, "netlink", "n", false, "Read TCP, UDP sockets with netlink 'sock_diag', including 'tcp_info'.")
+	ssCommand.PersistentFlags().BoolVar(&ssCmdFlag.summary, "summary", false, "Summarize '/proc/net/sockstat,sockstat6' and socket counts by state, protocol, local port and remote IP.")
+	ssCmdFlag.watch.register(ssCommand)
 }
 
 func ssCommandFunc(cmd *cobra.Command, args []string) error {
-	color.Set(color.FgMagenta)
-	fmt.Fprintf(os.Stdout, "\n'ss' to inspect '/proc/net/tcp,tcp6'\n\n")
-	color.Unset()
-
-	topt := inspect.WithTCP()
-	if ssCmdFlag.protocol == "tcp6" {
-		topt = inspect.WithTCP6()
-	} else if ssCmdFlag.protocol != "tcp" {
-		fmt.Fprintf(os.Stderr, "unknown protocol %q\n", ssCmdFlag.protocol)
-		os.Exit(233)
-	}
-	sss, err := inspect.GetSS(
-		topt,
+	opts := []inspect.OpFunc{
 		inspect.WithTopExecPath(ssCmdFlag.topExecPath),
-		inspect.WithTopLimit(ssCmdFlag.limit),
 		inspect.WithProgram(ssCmdFlag.program),
 		inspect.WithLocalPort(ssCmdFlag.localPort),
-	)
+	}
+	if ssCmdFlag.summary {
+		// summarize the whole network namespace, as in 'ss -s',
+		// since sockets with no owner (e.g. TIME_WAIT) can't be
+		// scoped to a program
+		if ssCmdFlag.program != "" || ssCmdFlag.localPort > 0 {
+			return fmt.Errorf("'--summary' can't be used with '--program' or '--local-port'")
+		}
+		opts = append(opts, inspect.WithUnowned())
+	} else {
+		opts = append(opts, inspect.WithTopLimit(ssCmdFlag.limit))
+	}
+	if ssCmdFlag.unowned {
+		opts = append(opts, inspect.WithUnowned())
+	}
+	if ssCmdFlag.netlink {
+		opts = append(opts, inspect.WithNetlink())
+	}
+	for _, p := range strings.Split(ssCmdFlag.protocol, ",") {
+		switch strings.TrimSpace(p) {
+		case "tcp":
+			opts = append(opts, inspect.WithTCP())
+		case "tcp6":
+			opts = append(opts, inspect.WithTCP6())
+		case "udp":
+			opts = append(opts, inspect.WithUDP())
+		case "udp6":
+			opts = append(opts, inspect.WithUDP6())
+		case "raw":
+			opts = append(opts, inspect.WithRaw())
+		case "raw6":
+			opts = append(opts, inspect.WithRaw6())
+		case "unix":
+			opts = append(opts, inspect.WithUnix())
+		default:
+			fmt.Fprintf(os.Stderr, "unknown protocol %q\n", p)
+			os.Exit(233)
+		}
+	}
+
+	if ssCmdFlag.summary {
+		return ssCmdFlag.watch.run(func() error {
+			return printSSSummary(opts)
+		})
+	}
+
+	return ssCmdFlag.watch.run(func() error {
+		printBanner("\n'ss' to inspect '/proc/net/tcp,tcp6,udp,udp6,raw,raw6,unix'\n\n")
+
+		sss, err := inspect.GetSS(opts...)
+		if err != nil {
+			return err
+		}
+		hd, rows := inspect.ConvertSS(sss...)
+		return printEntries(sss, hd, rows, inspect.StringSS)
+	})
+}
+
+// ssSummary is 'ss --summary' output.
+type ssSummary struct {
+	Sockstat proc.Sockstat
+	Counts   []inspect.SSCount
+}
+
+func printSSSummary(opts []inspect.OpFunc) error {
+	printBanner("\n'ss --summary' to inspect '/proc/net/sockstat,sockstat6' and socket counts\n\n")
+
+	st, err := proc.GetSockstat()
 	if err != nil {
 		return err
 	}
-	hd, rows := inspect.ConvertSS(sss...)
-	txt := inspect.StringSS(hd, rows, -1)
-	fmt.Print(txt)
-
-	color.Set(color.FgGreen)
-	fmt.Fprintf(os.Stdout, "\nDONE!\n")
-	color.Unset()
+	sss, err := inspect.GetSS(opts...)
+	if err != nil {
+		return err
+	}
+	cs := inspect.SummarizeSS(sss...)
 
-	return nil
+	hd, rows := inspect.ConvertSSCount(cs...)
+	return printEntries(ssSummary{Sockstat: st, Counts: cs}, hd, rows, func(hd []string, rows [][]string, topLimit int) string {
+		shd, srows := inspect.ConvertSockstat(st)
+		return fmt.Sprintf("sockets used: %d\n\n", st.SocketsUsed) +
+			inspect.StringSockstat(shd, srows, -1) + "\n" +
+			inspect.StringSSCount(hd, rows, ssCmdFlag.limit)
+	})
 }
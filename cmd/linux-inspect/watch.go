@@ -0,0 +1,54 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+type watchFlags struct {
+	watch    bool
+	interval time.Duration
+}
+
+func (wf *watchFlags) register(cmd *cobra.Command) {
+	cmd.PersistentFlags().BoolVarP(&wf.watch, "watch", "w", false, "Refresh periodically until interrupted.")
+	cmd.PersistentFlags().DurationVarP(&wf.interval, "interval", "i", time.Second, "Specify the refresh interval with '--watch'.")
+}
+
+// run calls 'fn' once, or every interval with '--watch'
+// until interrupted. In table output, each refresh clears
+// the screen so that it updates in place like 'top'.
+func (wf watchFlags) run(fn func() error) error {
+	if !wf.watch {
+		return fn()
+	}
+	if wf.interval <= 0 {
+		return fmt.Errorf("invalid interval %v", wf.interval)
+	}
+
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(sigc)
+
+	ticker := time.NewTicker(wf.interval)
+	defer ticker.Stop()
+	for {
+		if outputFormat == outputTable {
+			fmt.Fprint(os.Stdout, "\033[H\033[2J")
+		}
+		if err := fn(); err != nil {
+			return err
+		}
+
+		select {
+		case <-sigc:
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
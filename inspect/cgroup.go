@@ -0,0 +1,256 @@
+package inspect
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/gyuho/linux-inspect/cgroup"
+	"github.com/gyuho/linux-inspect/proc"
+
+	humanize "github.com/dustin/go-humanize"
+)
+
+// Container is the container and pod that own a cgroup.
+type Container struct {
+	// Runtime is the container runtime, such as 'docker',
+	// 'containerd', 'crio', 'libpod'. Empty if unknown
+	// (e.g. kubepods with the cgroupfs driver).
+	Runtime string
+	// ID is the 64-character hex container ID.
+	ID string
+	// PodUID is the Kubernetes pod UID, if under 'kubepods'.
+	PodUID string
+}
+
+var (
+	containerIDRegex = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+	// e.g. 'docker-$ID.scope', 'cri-containerd-$ID.scope'
+	containerScopeRegex = regexp.MustCompile(`^(docker|cri-containerd|crio|libpod)-([0-9a-f]{64})\.scope$`)
+
+	// e.g. 'pod0f4b1a5e-...' (cgroupfs driver)
+	podRegex = regexp.MustCompile(`^pod([0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12})$`)
+
+	// e.g. 'kubepods-burstable-pod0f4b1a5e_....slice' (systemd driver)
+	podSliceRegex = regexp.MustCompile(`^kubepods(?:-[a-z]+)*-pod([0-9a-f]{8}_[0-9a-f]{4}_[0-9a-f]{4}_[0-9a-f]{4}_[0-9a-f]{12})\.slice$`)
+)
+
+var containerRuntimes = map[string]string{
+	"docker":         "docker",
+	"cri-containerd": "containerd",
+	"crio":           "crio",
+	"libpod":         "libpod",
+}
+
+// ResolveContainer extracts the container ID and pod UID from the
+// cgroup path, in systemd, docker and kubepods layouts, such as:
+//
+//	/docker/$ID
+//	/system.slice/docker-$ID.scope
+//	/kubepods/burstable/pod$UID/$ID
+//	/kubepods.slice/kubepods-besteffort.slice/kubepods-besteffort-pod$UID.slice/cri-containerd-$ID.scope
+//	/machine.slice/libpod-$ID.scope
+//
+// It returns an empty Container if the path is not in a container.
+func ResolveContainer(cgroupPath string) Container {
+	var c Container
+	parent := ""
+	for _, elem := range strings.Split(path.Clean(cgroupPath), "/") {
+		switch {
+		case elem == "":
+		case containerIDRegex.MatchString(elem):
+			c.ID = elem
+			c.Runtime = ""
+			if parent == "docker" {
+				c.Runtime = "docker"
+			}
+		case containerScopeRegex.MatchString(elem):
+			ms := containerScopeRegex.FindStringSubmatch(elem)
+			c.Runtime, c.ID = containerRuntimes[ms[1]], ms[2]
+		case podRegex.MatchString(elem):
+			c.PodUID = podRegex.FindStringSubmatch(elem)[1]
+		case podSliceRegex.MatchString(elem):
+			c.PodUID = strings.Replace(podSliceRegex.FindStringSubmatch(elem)[1], "_", "-", -1)
+		}
+		parent = elem
+	}
+	return c
+}
+
+// cgroupPath returns the cgroup path of the process to attribute
+// containers. It prefers the cgroup v2 unified hierarchy, and falls
+// back to cgroup v1 'memory' or any other hierarchy when the unified
+// path is the root (e.g. hybrid mode).
+func cgroupPath(cgs []proc.Cgroup) string {
+	var v1 string
+	for _, cg := range cgs {
+		if cg.IsV2() {
+			if cg.Path != "/" {
+				return cg.Path
+			}
+			continue
+		}
+		if cg.Path == "/" {
+			continue
+		}
+		for _, ctl := range cg.Controllers {
+			if ctl == "memory" {
+				return cg.Path
+			}
+		}
+		if v1 == "" {
+			v1 = cg.Path
+		}
+	}
+	if v1 != "" {
+		return v1
+	}
+	if len(cgs) > 0 {
+		return cgs[0].Path
+	}
+	return ""
+}
+
+// CgroupStats is the resource accounting of a cgroup v2.
+// Simplified from 'cpu.stat', 'memory.*', 'io.stat', 'pids.current'
+// and 'cpu.pressure' in '/sys/fs/cgroup/$CGROUP'.
+type CgroupStats struct {
+	Path string
+
+	CPUUsage string
+	// ThrottledPeriods is the percentage of enforcement periods
+	// in which the cgroup was throttled ('nr_throttled / nr_periods').
+	ThrottledPeriods string
+	// ThrottledTime is the throttled time relative to the CPU usage
+	// ('throttled_usec / usage_usec').
+	ThrottledTime string
+
+	MemoryCurrent string
+	// MemoryMax is 'max' if there is no limit.
+	MemoryMax string
+	// MemoryHeadroom is the memory left before hitting 'memory.max'.
+	MemoryHeadroom string
+	// MemoryUsage is the percentage of 'memory.max' in use.
+	MemoryUsage string
+	OOMKills    uint64
+
+	IORead  string
+	IOWrite string
+
+	Pids uint64
+
+	// CPUPressure is 'avg10' of 'some' in 'cpu.pressure',
+	// empty if the kernel does not support PSI.
+	CPUPressure string
+
+	// extra fields for sorting
+	CPUUsageNum         time.Duration
+	ThrottledPeriodsNum float64
+	ThrottledTimeNum    float64
+	MemoryCurrentNum    uint64
+	MemoryMaxNum        uint64
+	MemoryHeadroomNum   uint64
+	MemoryUsageNum      float64
+	IOReadNum           uint64
+	IOWriteNum          uint64
+	CPUPressureNum      float64
+}
+
+// GetCgroupStats reads the cgroup v2 accounting files under
+// '/sys/fs/cgroup', where the path is relative to the root
+// (e.g. 'PSEntry.Cgroup'). Files of controllers that are not
+// enabled in the cgroup are skipped.
+func GetCgroupStats(cgroupPath string) (CgroupStats, error) {
+	fs, err := cgroup.NewFS(cgroup.DefaultRoot)
+	if err != nil {
+		return CgroupStats{}, err
+	}
+	return getCgroupStats(fs, cgroupPath)
+}
+
+func getCgroupStats(fs cgroup.FS, cgroupPath string) (CgroupStats, error) {
+	// 'cpu.stat' exists in all cgroups, even without cpu controller
+	cs, err := fs.GetCPUStat(cgroupPath)
+	if err != nil {
+		return CgroupStats{}, err
+	}
+	st := CgroupStats{
+		Path:        cgroupPath,
+		CPUUsageNum: time.Duration(cs.UsageUsec) * time.Microsecond,
+	}
+	st.CPUUsage = st.CPUUsageNum.String()
+	if cs.NrPeriods > 0 {
+		st.ThrottledPeriodsNum = 100 * float64(cs.NrThrottled) / float64(cs.NrPeriods)
+	}
+	if cs.UsageUsec > 0 {
+		st.ThrottledTimeNum = 100 * float64(cs.ThrottledUsec) / float64(cs.UsageUsec)
+	}
+	st.ThrottledPeriods = fmt.Sprintf("%3.2f %%", st.ThrottledPeriodsNum)
+	st.ThrottledTime = fmt.Sprintf("%3.2f %%", st.ThrottledTimeNum)
+
+	cur, err := fs.GetMemoryCurrent(cgroupPath)
+	if err != nil && !os.IsNotExist(err) {
+		return CgroupStats{}, err
+	}
+	// root cgroup has no 'memory.max'
+	max, err := fs.GetMemoryMax(cgroupPath)
+	if os.IsNotExist(err) {
+		max, err = cgroup.Max, nil
+	}
+	if err != nil {
+		return CgroupStats{}, err
+	}
+	st.MemoryCurrentNum, st.MemoryMaxNum = cur, max
+	st.MemoryCurrent = humanize.Bytes(cur)
+	if max == cgroup.Max {
+		st.MemoryMax = "max"
+		st.MemoryHeadroom = "max"
+		st.MemoryHeadroomNum = cgroup.Max
+	} else {
+		st.MemoryMax = humanize.Bytes(max)
+		if max > cur {
+			st.MemoryHeadroomNum = max - cur
+		}
+		st.MemoryHeadroom = humanize.Bytes(st.MemoryHeadroomNum)
+		if max > 0 {
+			st.MemoryUsageNum = 100 * float64(cur) / float64(max)
+		}
+	}
+	st.MemoryUsage = fmt.Sprintf("%3.2f %%", st.MemoryUsageNum)
+
+	ev, err := fs.GetMemoryEvents(cgroupPath)
+	if err != nil && !os.IsNotExist(err) {
+		return CgroupStats{}, err
+	}
+	st.OOMKills = ev.OomKill
+
+	ios, err := fs.GetIOStat(cgroupPath)
+	if err != nil && !os.IsNotExist(err) {
+		return CgroupStats{}, err
+	}
+	for _, s := range ios {
+		st.IOReadNum += s.RbytesBytesN
+		st.IOWriteNum += s.WbytesBytesN
+	}
+	st.IORead = humanize.Bytes(st.IOReadNum)
+	st.IOWrite = humanize.Bytes(st.IOWriteNum)
+
+	st.Pids, err = fs.GetPidsCurrent(cgroupPath)
+	if err != nil && !os.IsNotExist(err) {
+		return CgroupStats{}, err
+	}
+
+	p, err := fs.GetCPUPressure(cgroupPath)
+	switch {
+	case err == nil:
+		st.CPUPressureNum = p.Some.Avg10
+		st.CPUPressure = fmt.Sprintf("%3.2f %%", st.CPUPressureNum)
+	case !os.IsNotExist(err):
+		return CgroupStats{}, err
+	}
+	return st, nil
+}
@@ -0,0 +1,231 @@
+package inspect
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gyuho/linux-inspect/cgroup"
+	"github.com/gyuho/linux-inspect/proc"
+)
+
+func TestResolveContainer(t *testing.T) {
+	id := strings.Repeat("0123456789abcdef", 4)
+	tests := []struct {
+		path     string
+		expected Container
+	}{
+		{"/", Container{}},
+		{"/user.slice/user-1000.slice/session-2.scope", Container{}},
+		{"/docker/" + id, Container{Runtime: "docker", ID: id}},
+		{"/system.slice/docker-" + id + ".scope", Container{Runtime: "docker", ID: id}},
+		{"/machine.slice/libpod-" + id + ".scope", Container{Runtime: "libpod", ID: id}},
+		{
+			"/kubepods/burstable/pod0f4b1a5e-1c2d-4e5f-8a9b-0c1d2e3f4a5b/" + id,
+			Container{ID: id, PodUID: "0f4b1a5e-1c2d-4e5f-8a9b-0c1d2e3f4a5b"},
+		},
+		{
+			"/kubepods.slice/kubepods-besteffort.slice/kubepods-besteffort-pod0f4b1a5e_1c2d_4e5f_8a9b_0c1d2e3f4a5b.slice/cri-containerd-" + id + ".scope",
+			Container{Runtime: "containerd", ID: id, PodUID: "0f4b1a5e-1c2d-4e5f-8a9b-0c1d2e3f4a5b"},
+		},
+		{
+			"/kubepods.slice/kubepods-pod0f4b1a5e_1c2d_4e5f_8a9b_0c1d2e3f4a5b.slice/crio-" + id + ".scope",
+			Container{Runtime: "crio", ID: id, PodUID: "0f4b1a5e-1c2d-4e5f-8a9b-0c1d2e3f4a5b"},
+		},
+	}
+	for i, tt := range tests {
+		c := ResolveContainer(tt.path)
+		if !reflect.DeepEqual(c, tt.expected) {
+			t.Fatalf("#%d: expected %+v, got %+v", i, tt.expected, c)
+		}
+	}
+}
+
+func TestCgroupPath(t *testing.T) {
+	tests := []struct {
+		cgs      []proc.Cgroup
+		expected string
+	}{
+		{nil, ""},
+		{[]proc.Cgroup{{HierarchyID: 0, Path: "/system.slice/a.service"}}, "/system.slice/a.service"},
+		{
+			[]proc.Cgroup{
+				{HierarchyID: 2, Controllers: []string{"cpu"}, Path: "/a"},
+				{HierarchyID: 4, Controllers: []string{"memory"}, Path: "/b"},
+				{HierarchyID: 0, Path: "/"},
+			},
+			"/b",
+		},
+		{
+			[]proc.Cgroup{
+				{HierarchyID: 2, Controllers: []string{"cpu"}, Path: "/"},
+				{HierarchyID: 0, Path: "/"},
+			},
+			"/",
+		},
+	}
+	for i, tt := range tests {
+		if p := cgroupPath(tt.cgs); p != tt.expected {
+			t.Fatalf("#%d: expected %q, got %q", i, tt.expected, p)
+		}
+	}
+}
+
+func TestMatchCgroup(t *testing.T) {
+	id := strings.Repeat("0123456789abcdef", 4)
+	tests := []struct {
+		filter   string
+		cgroup   string
+		c        Container
+		expected bool
+	}{
+		{"", "/a", Container{}, true},
+		{"/", "/a", Container{}, true},
+		{"/kubepods/pod1", "/kubepods/pod1", Container{}, true},
+		{"/kubepods/pod1", "/kubepods/pod1/" + id, Container{}, true},
+		{"/kubepods/pod1/", "/kubepods/pod1/" + id, Container{}, true},
+		{"/kubepods/pod1", "/kubepods/pod12", Container{}, false},
+		{id[:12], "/docker/" + id, Container{ID: id}, true},
+		{"0f4b1a5e-1c2d-4e5f-8a9b-0c1d2e3f4a5b", "/a", Container{PodUID: "0f4b1a5e-1c2d-4e5f-8a9b-0c1d2e3f4a5b"}, true},
+	}
+	for i, tt := range tests {
+		op := &EntryOp{Cgroup: tt.filter}
+		if ok := op.matchCgroup(tt.cgroup, tt.c); ok != tt.expected {
+			t.Fatalf("#%d: expected %v, got %v", i, tt.expected, ok)
+		}
+	}
+}
+
+func TestGroupPSByContainer(t *testing.T) {
+	id := strings.Repeat("0123456789abcdef", 4)
+	_, rows := ConvertPS(
+		PSEntry{Program: "a", PID: 1, VMRSSNum: 4, Cgroup: "/docker/" + id, Container: Container{ID: id}},
+		PSEntry{Program: "b", PID: 2, VMRSSNum: 3, Cgroup: "/user.slice"},
+		PSEntry{Program: "c", PID: 3, VMRSSNum: 2, Cgroup: "/docker/" + id, Container: Container{ID: id}},
+		PSEntry{Program: "d", PID: 4, VMRSSNum: 1, Cgroup: "/kubepods/pod1", Container: Container{PodUID: "1"}},
+	)
+	GroupPSByContainer(rows)
+
+	var programs, containers []string
+	for _, row := range rows {
+		programs = append(programs, row[0])
+		containers = append(containers, row[13])
+	}
+	if !reflect.DeepEqual(programs, []string{"b", "a", "c", "d"}) {
+		t.Fatalf("unexpected order %v", programs)
+	}
+	if !reflect.DeepEqual(containers, []string{"/user.slice", id[:12], id[:12], "pod1"}) {
+		t.Fatalf("unexpected containers %v", containers)
+	}
+}
+
+func TestGetPSWithCgroup(t *testing.T) {
+	pid := int64(os.Getpid())
+	pss, err := GetPS(WithPID(pid))
+	if err != nil {
+		t.Skip(err)
+	}
+	if len(pss) != 1 || pss[0].Cgroup == "" {
+		t.Skipf("cgroup is not readable %+v", pss)
+	}
+
+	pss, err = GetPS(WithPID(pid), WithCgroup(pss[0].Cgroup))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pss) != 1 {
+		t.Fatalf("expected 1 entry, got %+v", pss)
+	}
+
+	pss, err = GetPS(WithPID(pid), WithCgroup("/not/exist"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pss) != 0 {
+		t.Fatalf("expected no entry, got %+v", pss)
+	}
+}
+
+func TestGetCgroupStats(t *testing.T) {
+	root, err := ioutil.TempDir(os.TempDir(), "cgroup-stats-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	cg := "/kubepods.slice/a.scope"
+	files := map[string]string{
+		"cpu.stat":       "usage_usec 2000000\nuser_usec 1500000\nsystem_usec 500000\nnr_periods 200\nnr_throttled 50\nthrottled_usec 500000\n",
+		"memory.current": "75000000\n",
+		"memory.max":     "100000000\n",
+		"memory.events":  "low 0\nhigh 0\nmax 3\noom 1\noom_kill 2\n",
+		"io.stat":        "8:0 rbytes=1000000 wbytes=2000 rios=10 wios=2\n253:0 rbytes=4000 wbytes=0 rios=1 wios=0\n",
+		"pids.current":   "7\n",
+		"cpu.pressure":   "some avg10=1.50 avg60=0.75 avg300=0.10 total=123456\n",
+		// child without controllers enabled
+		"b/cpu.stat": "usage_usec 0\nuser_usec 0\nsystem_usec 0\n",
+	}
+	for name, txt := range files {
+		fpath := filepath.Join(root, cg, name)
+		if err = os.MkdirAll(filepath.Dir(fpath), 0777); err != nil {
+			t.Fatal(err)
+		}
+		if err = ioutil.WriteFile(fpath, []byte(txt), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	fs, err := cgroup.NewFS(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	st, err := getCgroupStats(fs, cg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := CgroupStats{
+		Path:             cg,
+		CPUUsage:         "2s",
+		ThrottledPeriods: "25.00 %",
+		ThrottledTime:    "25.00 %",
+		MemoryCurrent:    "75 MB",
+		MemoryMax:        "100 MB",
+		MemoryHeadroom:   "25 MB",
+		MemoryUsage:      "75.00 %",
+		OOMKills:         2,
+		IORead:           "1.0 MB",
+		IOWrite:          "2.0 kB",
+		Pids:             7,
+		CPUPressure:      "1.50 %",
+
+		CPUUsageNum:         2 * time.Second,
+		ThrottledPeriodsNum: 25,
+		ThrottledTimeNum:    25,
+		MemoryCurrentNum:    75000000,
+		MemoryMaxNum:        100000000,
+		MemoryHeadroomNum:   25000000,
+		MemoryUsageNum:      75,
+		IOReadNum:           1004000,
+		IOWriteNum:          2000,
+		CPUPressureNum:      1.5,
+	}
+	if !reflect.DeepEqual(st, expected) {
+		t.Fatalf("expected %+v, got %+v", expected, st)
+	}
+
+	st, err = getCgroupStats(fs, filepath.Join(cg, "b"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st.MemoryMax != "max" || st.MemoryHeadroomNum != cgroup.Max || st.ThrottledPeriods != "0.00 %" || st.CPUPressure != "" {
+		t.Fatalf("unexpected %+v", st)
+	}
+
+	if _, err = getCgroupStats(fs, "/not/exist"); !os.IsNotExist(err) {
+		t.Fatalf("expected not exist error, got %v", err)
+	}
+}
@@ -0,0 +1,162 @@
+package inspect
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gyuho/linux-inspect/proc"
+)
+
+// DefaultCPUSampleInterval is the default delay between
+// two readings of a new CPUSampler, same as 'top -d 1'.
+const DefaultCPUSampleInterval = time.Second
+
+// CPUSampler computes per-process CPU usage from two readings of
+// 'utime' and 'stime' in '/proc/$PID/stat' and total jiffies in '/proc/stat'.
+// It does not require 'top', and can be reused across calls
+// (e.g. 'WithCPUSampler'), in which case each call reports
+// the usage since the previous one.
+type CPUSampler struct {
+	// Interval is the delay between the first two readings.
+	Interval time.Duration
+
+	mu          sync.Mutex
+	prev        map[int64]cpuReading
+	prevThreads map[int64]cpuReading
+}
+
+type cpuReading struct {
+	processTicks uint64
+	totalTicks   uint64
+	cpus         int
+	unixNano     int64
+}
+
+// NewCPUSampler returns a new CPUSampler.
+func NewCPUSampler(interval time.Duration) *CPUSampler {
+	if interval <= 0 {
+		interval = DefaultCPUSampleInterval
+	}
+	return &CPUSampler{
+		Interval:    interval,
+		prev:        make(map[int64]cpuReading),
+		prevThreads: make(map[int64]cpuReading),
+	}
+}
+
+// Sample returns the CPU usage of each PID in percent, where 100%
+// is one CPU fully used, as in 'top'. When none of the PIDs has been
+// sampled before, it reads twice with 'Interval' in between.
+// PIDs first seen by a reused sampler report 0%, and PIDs not in
+// 'pids' are forgotten.
+func (s *CPUSampler) Sample(pids []int64) (map[int64]float64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sample(s.prev, pids, proc.GetStatByPID)
+}
+
+// SampleThreads returns the CPU usage of each thread in percent,
+// from '/proc/$PID/task/$TID/stat', where 'pidToTIDs' maps each PID
+// to its thread IDs. All threads are sampled at once. Note that
+// '/proc/$TID/stat' reports the whole thread group instead.
+func (s *CPUSampler) SampleThreads(pidToTIDs map[int64][]int64) (map[int64]float64, error) {
+	tidToPID := make(map[int64]int64)
+	var tids []int64
+	for pid, ts := range pidToTIDs {
+		for _, tid := range ts {
+			tidToPID[tid] = pid
+			tids = append(tids, tid)
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sample(s.prevThreads, tids, func(tid int64) (proc.Stat, error) {
+		return proc.GetStatByTID(tidToPID[tid], tid)
+	})
+}
+
+func (s *CPUSampler) sample(prev map[int64]cpuReading, ids []int64, statFunc func(int64) (proc.Stat, error)) (map[int64]float64, error) {
+	primed := false
+	for _, id := range ids {
+		if _, ok := prev[id]; ok {
+			primed = true
+			break
+		}
+	}
+	if !primed {
+		if err := read(prev, ids, statFunc, nil); err != nil {
+			return nil, err
+		}
+		time.Sleep(s.Interval)
+	}
+
+	cpuM := make(map[int64]float64, len(ids))
+	if err := read(prev, ids, statFunc, cpuM); err != nil {
+		return nil, err
+	}
+
+	// drop the IDs no longer queried (e.g. exited), so that a reused
+	// sampler does not grow, and a reused ID starts from a new reading
+	cur := make(map[int64]struct{}, len(ids))
+	for _, id := range ids {
+		cur[id] = struct{}{}
+	}
+	for id := range prev {
+		if _, ok := cur[id]; !ok {
+			delete(prev, id)
+		}
+	}
+	return cpuM, nil
+}
+
+// read takes a new reading for the IDs, and computes
+// the usage against the previous reading if cpuM is not nil.
+func read(prev map[int64]cpuReading, ids []int64, statFunc func(int64) (proc.Stat, error), cpuM map[int64]float64) error {
+	total, cpus, err := proc.GetTotalJiffies()
+	if err != nil {
+		return err
+	}
+	now := time.Now().UnixNano()
+
+	for _, id := range ids {
+		stat, err := statFunc(id)
+		if err != nil {
+			// process may have exited
+			delete(prev, id)
+			continue
+		}
+		cur := cpuReading{
+			processTicks: stat.Utime + stat.Stime,
+			totalTicks:   total,
+			cpus:         cpus,
+			unixNano:     now,
+		}
+		if cpuM != nil {
+			cpuM[id] = cpuPercent(prev[id], cur)
+		}
+		prev[id] = cur
+	}
+	return nil
+}
+
+var clockTicks = proc.GetClockTicks()
+
+// cpuPercent computes the CPU usage between two readings.
+func cpuPercent(prev, cur cpuReading) float64 {
+	if prev.unixNano == 0 || cur.processTicks < prev.processTicks {
+		return 0
+	}
+
+	// ticks elapsed on a single CPU
+	var elapsed float64
+	if cur.totalTicks > prev.totalTicks && cur.cpus > 0 {
+		elapsed = float64(cur.totalTicks-prev.totalTicks) / float64(cur.cpus)
+	} else {
+		elapsed = float64(cur.unixNano-prev.unixNano) / float64(time.Second) * float64(clockTicks)
+	}
+	if elapsed <= 0 {
+		return 0
+	}
+	return float64(cur.processTicks-prev.processTicks) / elapsed * 100
+}
@@ -0,0 +1,66 @@
+package inspect
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gyuho/linux-inspect/proc"
+)
+
+func TestCPUSampler(t *testing.T) {
+	pid := int64(os.Getpid())
+
+	donec := make(chan struct{})
+	go func() {
+		// keep one CPU busy
+		for {
+			select {
+			case <-donec:
+				return
+			default:
+			}
+		}
+	}()
+	defer close(donec)
+
+	s := NewCPUSampler(500 * time.Millisecond)
+	cpuM, err := s.Sample([]int64{pid})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fmt.Printf("CPUSampler: %+v\n", cpuM)
+	if cpuM[pid] <= 0 {
+		t.Fatalf("expected CPU usage > 0, got %f", cpuM[pid])
+	}
+
+	// reused sampler reports against the previous reading
+	time.Sleep(200 * time.Millisecond)
+	cpuM, err = s.Sample([]int64{pid})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cpuM[pid] <= 0 {
+		t.Fatalf("expected CPU usage > 0, got %f", cpuM[pid])
+	}
+}
+
+func TestCPUSamplerForget(t *testing.T) {
+	s := NewCPUSampler(time.Millisecond)
+	statFunc := func(id int64) (proc.Stat, error) {
+		return proc.Stat{Pid: id, Utime: 10, Stime: 5}, nil
+	}
+	if _, err := s.sample(s.prev, []int64{1, 2}, statFunc); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.sample(s.prev, []int64{2, 3}, statFunc); err != nil {
+		t.Fatal(err)
+	}
+	if len(s.prev) != 2 {
+		t.Fatalf("expected 2 readings, got %+v", s.prev)
+	}
+	if _, ok := s.prev[1]; ok {
+		t.Fatalf("expected PID 1 to be forgotten, got %+v", s.prev)
+	}
+}
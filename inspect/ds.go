@@ -3,9 +3,11 @@ package inspect
 import (
 	"bytes"
 	"fmt"
+	"time"
 
 	"github.com/gyuho/linux-inspect/proc"
 
+	humanize "github.com/dustin/go-humanize"
 	"github.com/gyuho/dataframe"
 	"github.com/olekukonko/tablewriter"
 )
@@ -116,3 +118,122 @@ func StringDS(header []string, rows [][]string, topLimit int) string {
 
 	return buf.String()
 }
+
+// DSRateEntry represents per-second disk statistics
+// between two 'DSEntry' snapshots.
+type DSRateEntry struct {
+	Device string
+
+	ReadIOPS            float64
+	ReadBytesPerSecond  string
+	WriteIOPS           float64
+	WriteBytesPerSecond string
+
+	// extra fields for sorting
+	ReadBytesPerSecondNum  uint64
+	WriteBytesPerSecondNum uint64
+}
+
+// GetDSRate computes per-second rates of each device in both
+// 'prev' and 'cur', taken 'elapsed' apart.
+func GetDSRate(prev, cur []DSEntry, elapsed time.Duration) []DSRateEntry {
+	if elapsed <= 0 {
+		return nil
+	}
+	sec := elapsed.Seconds()
+
+	pm := make(map[string]DSEntry, len(prev))
+	for _, elem := range prev {
+		pm[elem.Device] = elem
+	}
+	var rs []DSRateEntry
+	for _, elem := range cur {
+		p, ok := pm[elem.Device]
+		if !ok {
+			continue
+		}
+		// SECTOR_SIZE is 512 in Linux kernel
+		readBytes := uint64(float64(counterDelta(p.SectorsRead, elem.SectorsRead)*512) / sec)
+		writeBytes := uint64(float64(counterDelta(p.SectorsWritten, elem.SectorsWritten)*512) / sec)
+		rs = append(rs, DSRateEntry{
+			Device: elem.Device,
+
+			ReadIOPS:            float64(counterDelta(p.ReadsCompleted, elem.ReadsCompleted)) / sec,
+			ReadBytesPerSecond:  humanize.Bytes(readBytes) + "/s",
+			WriteIOPS:           float64(counterDelta(p.WritesCompleted, elem.WritesCompleted)) / sec,
+			WriteBytesPerSecond: humanize.Bytes(writeBytes) + "/s",
+
+			ReadBytesPerSecondNum:  readBytes,
+			WriteBytesPerSecondNum: writeBytes,
+		})
+	}
+	return rs
+}
+
+// counterDelta returns 0 if the counter was reset.
+func counterDelta(prev, cur uint64) uint64 {
+	if cur < prev {
+		return 0
+	}
+	return cur - prev
+}
+
+const columnsDSRateToShow = 5
+
+var columnsDSRateEntry = []string{
+	"DEVICE",
+
+	"READ-IOPS", "READ-BYTES/S",
+	"WRITE-IOPS", "WRITE-BYTES/S",
+
+	// extra for sorting
+	"READ-BYTES/S-NUM",
+	"WRITE-BYTES/S-NUM",
+}
+
+// ConvertDSRate converts to rows.
+func ConvertDSRate(dss ...DSRateEntry) (header []string, rows [][]string) {
+	header = columnsDSRateEntry
+	rows = make([][]string, len(dss))
+	for i, elem := range dss {
+		row := make([]string, len(columnsDSRateEntry))
+		row[0] = elem.Device
+
+		row[1] = fmt.Sprintf("%.2f", elem.ReadIOPS)
+		row[2] = elem.ReadBytesPerSecond
+		row[3] = fmt.Sprintf("%.2f", elem.WriteIOPS)
+		row[4] = elem.WriteBytesPerSecond
+
+		row[5] = fmt.Sprintf("%d", elem.ReadBytesPerSecondNum)
+		row[6] = fmt.Sprintf("%d", elem.WriteBytesPerSecondNum)
+
+		rows[i] = row
+	}
+	dataframe.SortBy(
+		rows,
+		dataframe.Float64DescendingFunc(6), // WriteBytesPerSecond
+		dataframe.Float64DescendingFunc(5), // ReadBytesPerSecond
+	).Sort(rows)
+
+	return
+}
+
+// StringDSRate converts in print-friendly format.
+func StringDSRate(header []string, rows [][]string, topLimit int) string {
+	buf := new(bytes.Buffer)
+	tw := tablewriter.NewWriter(buf)
+	tw.SetHeader(header[:columnsDSRateToShow:columnsDSRateToShow])
+
+	if topLimit > 0 && len(rows) > topLimit {
+		rows = rows[:topLimit:topLimit]
+	}
+
+	for _, row := range rows {
+		tw.Append(row[:columnsDSRateToShow:columnsDSRateToShow])
+	}
+	tw.SetAutoFormatHeaders(false)
+	tw.SetAlignment(tablewriter.ALIGN_RIGHT)
+	tw.Render()
+
+	return buf.String()
+}
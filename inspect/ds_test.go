@@ -3,6 +3,7 @@ package inspect
 import (
 	"fmt"
 	"testing"
+	"time"
 )
 
 func TestGetDS(t *testing.T) {
@@ -14,3 +15,28 @@ func TestGetDS(t *testing.T) {
 	txt := StringDS(hd, rows, -1)
 	fmt.Println(txt)
 }
+
+func TestGetDSRate(t *testing.T) {
+	prev := []DSEntry{
+		{Device: "sda", ReadsCompleted: 100, SectorsRead: 1000, WritesCompleted: 50, SectorsWritten: 2000},
+		{Device: "sdb", ReadsCompleted: 100},
+	}
+	cur := []DSEntry{
+		{Device: "sda", ReadsCompleted: 300, SectorsRead: 5000, WritesCompleted: 60, SectorsWritten: 2000},
+		{Device: "sdb", ReadsCompleted: 10}, // reset
+		{Device: "sdc", ReadsCompleted: 10}, // new
+	}
+	rs := GetDSRate(prev, cur, 2*time.Second)
+	if len(rs) != 2 {
+		t.Fatalf("expected 2 entries, got %+v", rs)
+	}
+	if rs[0].ReadIOPS != 100 || rs[0].WriteIOPS != 5 || rs[0].ReadBytesPerSecondNum != 1024000 || rs[0].WriteBytesPerSecondNum != 0 {
+		t.Fatalf("unexpected rate %+v", rs[0])
+	}
+	if rs[1].ReadIOPS != 0 {
+		t.Fatalf("reset counter expected 0, got %+v", rs[1])
+	}
+	hd, rows := ConvertDSRate(rs...)
+	txt := StringDSRate(hd, rows, -1)
+	fmt.Println(txt)
+}
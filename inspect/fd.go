@@ -0,0 +1,252 @@
+package inspect
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/gyuho/linux-inspect/proc"
+
+	humanize "github.com/dustin/go-humanize"
+	"github.com/olekukonko/tablewriter"
+	"golang.org/x/sys/unix"
+)
+
+// File descriptor types in 'FDEntry'.
+const (
+	FDTypeFile      = "file"
+	FDTypeDeleted   = "deleted"
+	FDTypeDir       = "dir"
+	FDTypeDevice    = "device"
+	FDTypeSocket    = "socket"
+	FDTypePipe      = "pipe"
+	FDTypeEventFD   = "eventfd"
+	FDTypeAnonInode = "anon_inode"
+	FDTypeOther     = "other"
+)
+
+// FDEntry is an open file descriptor entry.
+// Simplied from '/proc/$PID/fd' and '/proc/$PID/fdinfo'.
+type FDEntry struct {
+	Program string
+	PID     int64
+	FD      int64
+
+	// Type is one of 'FDTypeFile', 'FDTypeSocket', etc..
+	Type   string
+	Target string
+
+	// Flags is the file access mode and status flags,
+	// such as 'O_WRONLY|O_APPEND|O_CLOEXEC'.
+	Flags string
+	// Offset is the current file offset.
+	Offset uint64
+	// Size is the size of regular (or deleted) files.
+	Size string
+
+	// extra fields for sorting
+	SizeNum uint64
+}
+
+// GetFDs reads all open file descriptors of the PID.
+// Reading other users' file descriptors needs root permission.
+func GetFDs(pid int64) ([]FDEntry, error) {
+	fds, err := proc.GetFDs(pid)
+	if err != nil {
+		return nil, err
+	}
+	program, err := proc.GetCommByPID(pid)
+	if err != nil {
+		return nil, err
+	}
+
+	fes := make([]FDEntry, 0, len(fds))
+	for _, fd := range fds {
+		tp := fdType(fd)
+		var size uint64
+		if tp == FDTypeFile || tp == FDTypeDeleted {
+			size = uint64(fd.Size)
+		}
+		fes = append(fes, FDEntry{
+			Program: program,
+			PID:     pid,
+			FD:      fd.FD,
+
+			Type:   tp,
+			Target: fd.Target,
+
+			Flags:  fdFlags(fd.Flags),
+			Offset: fd.Pos,
+			Size:   humanize.Bytes(size),
+
+			SizeNum: size,
+		})
+	}
+	return fes, nil
+}
+
+// countOpenFDs counts the open file descriptors of the PIDs,
+// skipping the ones whose '/proc/$PID/fd' is not readable.
+func countOpenFDs(pids []int64) map[int64]uint64 {
+	var mu sync.Mutex
+	fds := make(map[int64]uint64, len(pids))
+
+	var wg sync.WaitGroup
+	limitc := make(chan struct{}, maxConcurrentProcFDLimit)
+	wg.Add(len(pids))
+	for _, pid := range pids {
+		go func(pid int64) {
+			defer func() {
+				<-limitc
+				wg.Done()
+			}()
+			limitc <- struct{}{}
+
+			n, err := proc.CountFDs(pid)
+			if err != nil {
+				return
+			}
+			mu.Lock()
+			fds[pid] = n
+			mu.Unlock()
+		}(pid)
+	}
+	wg.Wait()
+	return fds
+}
+
+// fdType classifies the file descriptor by its target
+// (e.g. 'socket:[12345]', 'anon_inode:[eventfd]'), or
+// by the mode of the open file.
+func fdType(fd proc.FD) string {
+	switch {
+	case strings.HasPrefix(fd.Target, "socket:["):
+		return FDTypeSocket
+	case strings.HasPrefix(fd.Target, "pipe:["):
+		return FDTypePipe
+	case fd.Target == "anon_inode:[eventfd]":
+		return FDTypeEventFD
+	case strings.HasPrefix(fd.Target, "anon_inode:"):
+		return FDTypeAnonInode
+	case !strings.HasPrefix(fd.Target, "/"):
+		// e.g. 'net:[4026531840]' for namespace files
+		return FDTypeOther
+	case strings.HasSuffix(fd.Target, " (deleted)"):
+		return FDTypeDeleted
+	}
+	switch {
+	case fd.Mode.IsDir():
+		return FDTypeDir
+	case fd.Mode&os.ModeDevice != 0:
+		return FDTypeDevice
+	case fd.Mode.IsRegular():
+		return FDTypeFile
+	case fd.Mode&os.ModeNamedPipe != 0:
+		return FDTypePipe
+	case fd.Mode&os.ModeSocket != 0:
+		return FDTypeSocket
+	default:
+		return FDTypeOther
+	}
+}
+
+var fdStatusFlags = []struct {
+	flag uint64
+	name string
+}{
+	{unix.O_APPEND, "O_APPEND"},
+	{unix.O_NONBLOCK, "O_NONBLOCK"},
+	{unix.O_SYNC, "O_SYNC"},
+	{unix.O_DSYNC, "O_DSYNC"},
+	{unix.O_DIRECT, "O_DIRECT"},
+	{unix.O_DIRECTORY, "O_DIRECTORY"},
+	{unix.O_NOATIME, "O_NOATIME"},
+	{unix.O_PATH, "O_PATH"},
+	{unix.O_CLOEXEC, "O_CLOEXEC"},
+}
+
+// fdFlags converts the flags in 'fdinfo' to string.
+func fdFlags(flags uint64) string {
+	var ss []string
+	switch flags & unix.O_ACCMODE {
+	case unix.O_RDONLY:
+		ss = append(ss, "O_RDONLY")
+	case unix.O_WRONLY:
+		ss = append(ss, "O_WRONLY")
+	case unix.O_RDWR:
+		ss = append(ss, "O_RDWR")
+	}
+	for _, f := range fdStatusFlags {
+		if flags&f.flag == f.flag {
+			ss = append(ss, f.name)
+			// 'O_SYNC' includes 'O_DSYNC'
+			flags &^= f.flag
+		}
+	}
+	return strings.Join(ss, "|")
+}
+
+const columnsFDToShow = 8
+
+var columnsFDEntry = []string{
+	"PROGRAM",
+	"PID",
+	"FD",
+
+	"TYPE",
+	"FLAGS",
+	"OFFSET",
+	"SIZE",
+	"TARGET",
+
+	// extra for sorting
+	"SIZE-NUM",
+}
+
+// ConvertFDs converts to rows, in the given order.
+func ConvertFDs(fes ...FDEntry) (header []string, rows [][]string) {
+	header = columnsFDEntry
+	rows = make([][]string, len(fes))
+	for i, elem := range fes {
+		row := make([]string, len(columnsFDEntry))
+		row[0] = elem.Program
+		row[1] = fmt.Sprintf("%d", elem.PID)
+		row[2] = fmt.Sprintf("%d", elem.FD)
+
+		row[3] = elem.Type
+		row[4] = elem.Flags
+		row[5] = fmt.Sprintf("%d", elem.Offset)
+		row[6] = elem.Size
+		row[7] = elem.Target
+
+		row[8] = fmt.Sprintf("%d", elem.SizeNum)
+
+		rows[i] = row
+	}
+	return
+}
+
+// StringFDs converts in print-friendly format.
+func StringFDs(header []string, rows [][]string, topLimit int) string {
+	buf := new(bytes.Buffer)
+	tw := tablewriter.NewWriter(buf)
+	tw.SetHeader(header[:columnsFDToShow:columnsFDToShow])
+
+	if topLimit > 0 && len(rows) > topLimit {
+		rows = rows[:topLimit:topLimit]
+	}
+
+	for _, row := range rows {
+		tw.Append(row[:columnsFDToShow:columnsFDToShow])
+	}
+	tw.SetAutoFormatHeaders(false)
+	tw.SetAlignment(tablewriter.ALIGN_RIGHT)
+
+	// do not break targets with spaces (e.g. ' (deleted)')
+	tw.SetAutoWrapText(false)
+	tw.Render()
+
+	return buf.String()
+}
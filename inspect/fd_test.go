@@ -0,0 +1,82 @@
+package inspect
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/gyuho/linux-inspect/proc"
+)
+
+func TestFDType(t *testing.T) {
+	tests := []struct {
+		fd proc.FD
+		tp string
+	}{
+		{proc.FD{Target: "socket:[12345]"}, FDTypeSocket},
+		{proc.FD{Target: "pipe:[12345]"}, FDTypePipe},
+		{proc.FD{Target: "anon_inode:[eventfd]"}, FDTypeEventFD},
+		{proc.FD{Target: "anon_inode:[eventpoll]"}, FDTypeAnonInode},
+		{proc.FD{Target: "net:[4026531840]"}, FDTypeOther},
+		{proc.FD{Target: "/var/log/app.log (deleted)"}, FDTypeDeleted},
+		{proc.FD{Target: "/var/log/app.log"}, FDTypeFile},
+		{proc.FD{Target: "/tmp", Mode: os.ModeDir}, FDTypeDir},
+		{proc.FD{Target: "/dev/null", Mode: os.ModeDevice | os.ModeCharDevice}, FDTypeDevice},
+	}
+	for i, tt := range tests {
+		if tp := fdType(tt.fd); tp != tt.tp {
+			t.Fatalf("#%d: type expected %q, got %q", i, tt.tp, tp)
+		}
+	}
+}
+
+func TestFDFlags(t *testing.T) {
+	tests := []struct {
+		flags uint64
+		exp   string
+	}{
+		{0100000, "O_RDONLY"},
+		{02100002, "O_RDWR|O_CLOEXEC"},
+		{02002001, "O_WRONLY|O_APPEND|O_CLOEXEC"},
+		{04010001, "O_WRONLY|O_SYNC"},
+		{010001, "O_WRONLY|O_DSYNC"},
+	}
+	for i, tt := range tests {
+		if s := fdFlags(tt.flags); s != tt.exp {
+			t.Fatalf("#%d: flags expected %q, got %q", i, tt.exp, s)
+		}
+	}
+}
+
+func TestGetFDs(t *testing.T) {
+	f, err := ioutil.TempFile(os.TempDir(), "inspect-fd-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if _, err = f.WriteString("hello"); err != nil {
+		t.Fatal(err)
+	}
+	os.Remove(f.Name())
+
+	fes, err := GetFDs(int64(os.Getpid()))
+	if err != nil {
+		t.Skip(err)
+	}
+	found := false
+	for _, fe := range fes {
+		if fe.FD != int64(f.Fd()) {
+			continue
+		}
+		found = true
+		if fe.Type != FDTypeDeleted || fe.SizeNum != 5 || fe.Offset != 5 {
+			t.Fatalf("unexpected %+v", fe)
+		}
+	}
+	if !found {
+		t.Fatalf("fd %d was not found", f.Fd())
+	}
+	hd, rows := ConvertFDs(fes...)
+	fmt.Println(StringFDs(hd, rows, -1))
+}
@@ -0,0 +1,226 @@
+package inspect
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/gyuho/linux-inspect/proc"
+
+	humanize "github.com/dustin/go-humanize"
+	"github.com/gyuho/dataframe"
+	"github.com/olekukonko/tablewriter"
+)
+
+// LimitsEntry is a resource usage of a process against its limits.
+// Simplied from '/proc/$PID/limits' and '/proc/$PID/status'.
+type LimitsEntry struct {
+	Program string
+	PID     int64
+
+	// Resource is the limit name, such as 'Max open files'.
+	Resource  string
+	Usage     string
+	SoftLimit string
+	HardLimit string
+	// Percent is the usage against the soft limit,
+	// 0 if the soft limit is 'unlimited'.
+	Percent string
+
+	// extra fields for sorting
+	UsageNum     uint64
+	SoftLimitNum proc.RLimit
+	HardLimitNum proc.RLimit
+	PercentNum   float64
+}
+
+// GetLimits compares the current usage of the PID against its limits:
+// open file descriptors against 'Max open files' (RLIMIT_NOFILE),
+// threads of all processes with the same real UID against 'Max processes'
+// (RLIMIT_NPROC, which counts all threads of the user), virtual memory size
+// against 'Max address space' (RLIMIT_AS), and resident set size against
+// 'Max resident set' (RLIMIT_RSS, which is not enforced since Linux 2.6).
+func GetLimits(pid int64) ([]LimitsEntry, error) {
+	uidToThreads, err := countThreadsByUID()
+	if err != nil {
+		return nil, err
+	}
+	return getLimits(pid, uidToThreads)
+}
+
+// GetLimitsByPIDs is same as 'GetLimits', but for all PIDs, counting the
+// threads of each user only once. PIDs that can't be read (e.g. exited,
+// or needs root permission) are skipped.
+func GetLimitsByPIDs(pids []int64) ([]LimitsEntry, error) {
+	uidToThreads, err := countThreadsByUID()
+	if err != nil {
+		return nil, err
+	}
+	var les []LimitsEntry
+	for _, pid := range pids {
+		es, err := getLimits(pid, uidToThreads)
+		if err != nil {
+			log.Printf("getLimits error %v for PID %d", err, pid)
+			continue
+		}
+		les = append(les, es...)
+	}
+	return les, nil
+}
+
+func getLimits(pid int64, uidToThreads map[string]uint64) ([]LimitsEntry, error) {
+	ls, err := proc.GetLimits(pid)
+	if err != nil {
+		return nil, err
+	}
+	status, err := proc.GetStatusByPID(pid)
+	if err != nil {
+		return nil, err
+	}
+	fds, err := proc.CountFDs(pid)
+	if err != nil {
+		return nil, err
+	}
+
+	threads, ok := uidToThreads[realUID(status.Uid)]
+	if !ok {
+		// process started after counting
+		threads = status.Threads
+	}
+	les := []LimitsEntry{
+		newLimitsEntry(ls.OpenFiles, fds, false),
+		newLimitsEntry(ls.Processes, threads, false),
+		newLimitsEntry(ls.AddressSpace, status.VmSizeBytesN, true),
+		newLimitsEntry(ls.ResidentSet, status.VmRSSBytesN, true),
+	}
+	for i := range les {
+		les[i].Program = status.Name
+		les[i].PID = pid
+	}
+	return les, nil
+}
+
+// countThreadsByUID sums up the threads of all processes by real UID.
+func countThreadsByUID() (map[string]uint64, error) {
+	pids, err := proc.ListPIDs()
+	if err != nil {
+		return nil, err
+	}
+	uidToThreads := make(map[string]uint64)
+	for _, pid := range pids {
+		status, err := proc.GetStatusByPID(pid)
+		if err != nil {
+			// process may have exited
+			continue
+		}
+		uidToThreads[realUID(status.Uid)] += status.Threads
+	}
+	return uidToThreads, nil
+}
+
+// realUID returns the real UID, the first of 'Uid' in '/proc/$PID/status'.
+func realUID(uid string) string {
+	fs := strings.Fields(uid)
+	if len(fs) == 0 {
+		return ""
+	}
+	return fs[0]
+}
+
+func newLimitsEntry(l proc.Limit, usage uint64, isBytes bool) LimitsEntry {
+	format := func(v uint64) string {
+		if isBytes {
+			return humanize.Bytes(v)
+		}
+		return fmt.Sprintf("%d", v)
+	}
+	formatLimit := func(r proc.RLimit) string {
+		if r.Unlimited() {
+			return r.String()
+		}
+		return format(uint64(r))
+	}
+
+	pct := 0.0
+	if !l.Soft.Unlimited() && l.Soft > 0 {
+		pct = 100 * float64(usage) / float64(l.Soft)
+	}
+	return LimitsEntry{
+		Resource:  l.Name,
+		Usage:     format(usage),
+		SoftLimit: formatLimit(l.Soft),
+		HardLimit: formatLimit(l.Hard),
+		Percent:   fmt.Sprintf("%3.2f %%", pct),
+
+		UsageNum:     usage,
+		SoftLimitNum: l.Soft,
+		HardLimitNum: l.Hard,
+		PercentNum:   pct,
+	}
+}
+
+const columnsLimitsToShow = 7
+
+var columnsLimitsEntry = []string{
+	"PROGRAM",
+	"PID",
+
+	"RESOURCE",
+	"USAGE",
+	"SOFT-LIMIT",
+	"HARD-LIMIT",
+	"PERCENT",
+
+	// extra for sorting
+	"USAGE-NUM",
+	"PERCENT-NUM",
+}
+
+// ConvertLimits converts to rows.
+func ConvertLimits(les ...LimitsEntry) (header []string, rows [][]string) {
+	header = columnsLimitsEntry
+	rows = make([][]string, len(les))
+	for i, elem := range les {
+		row := make([]string, len(columnsLimitsEntry))
+		row[0] = elem.Program
+		row[1] = fmt.Sprintf("%d", elem.PID)
+
+		row[2] = elem.Resource
+		row[3] = elem.Usage
+		row[4] = elem.SoftLimit
+		row[5] = elem.HardLimit
+		row[6] = elem.Percent
+
+		row[7] = fmt.Sprintf("%d", elem.UsageNum)
+		row[8] = fmt.Sprintf("%3.2f", elem.PercentNum)
+
+		rows[i] = row
+	}
+	dataframe.SortBy(
+		rows,
+		dataframe.Float64DescendingFunc(8), // PercentNum
+	).Sort(rows)
+
+	return
+}
+
+// StringLimits converts in print-friendly format.
+func StringLimits(header []string, rows [][]string, topLimit int) string {
+	buf := new(bytes.Buffer)
+	tw := tablewriter.NewWriter(buf)
+	tw.SetHeader(header[:columnsLimitsToShow:columnsLimitsToShow])
+
+	if topLimit > 0 && len(rows) > topLimit {
+		rows = rows[:topLimit:topLimit]
+	}
+
+	for _, row := range rows {
+		tw.Append(row[:columnsLimitsToShow:columnsLimitsToShow])
+	}
+	tw.SetAutoFormatHeaders(false)
+	tw.SetAlignment(tablewriter.ALIGN_RIGHT)
+	tw.Render()
+
+	return buf.String()
+}
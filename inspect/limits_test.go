@@ -0,0 +1,53 @@
+package inspect
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/gyuho/linux-inspect/proc"
+)
+
+func TestNewLimitsEntry(t *testing.T) {
+	le := newLimitsEntry(proc.Limit{Name: "Max open files", Soft: 1024, Hard: 4096, Units: "files"}, 900, false)
+	if le.PercentNum < 87.8 || le.PercentNum > 87.9 {
+		t.Fatalf("percent expected 87.89, got %f", le.PercentNum)
+	}
+	if le.Usage != "900" || le.SoftLimit != "1024" || le.HardLimit != "4096" || le.Percent != "87.89 %" {
+		t.Fatalf("unexpected %+v", le)
+	}
+
+	le = newLimitsEntry(proc.Limit{Name: "Max address space", Soft: proc.RLimitInfinity, Hard: proc.RLimitInfinity, Units: "bytes"}, 1<<30, true)
+	if le.PercentNum != 0 || le.SoftLimit != "unlimited" || le.Usage != "1.1 GB" {
+		t.Fatalf("unexpected %+v", le)
+	}
+}
+
+func TestRealUID(t *testing.T) {
+	if uid := realUID("1000\t1001\t1002\t1003"); uid != "1000" {
+		t.Fatalf("uid expected 1000, got %q", uid)
+	}
+}
+
+func TestGetLimits(t *testing.T) {
+	les, err := GetLimits(int64(os.Getpid()))
+	if err != nil {
+		t.Skip(err)
+	}
+	if len(les) != 4 {
+		t.Fatalf("len(les) expected 4, got %d", len(les))
+	}
+	if les[0].Resource != "Max open files" || les[0].UsageNum == 0 {
+		t.Fatalf("unexpected %+v", les[0])
+	}
+	// threads of this process, and of all other processes of the user
+	status, err := proc.GetStatusByPID(int64(os.Getpid()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if les[1].Resource != "Max processes" || les[1].UsageNum < status.Threads {
+		t.Fatalf("unexpected %+v", les[1])
+	}
+	hd, rows := ConvertLimits(les...)
+	fmt.Println(StringLimits(hd, rows, -1))
+}
@@ -0,0 +1,157 @@
+package inspect
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gyuho/linux-inspect/proc"
+
+	humanize "github.com/dustin/go-humanize"
+	"github.com/gyuho/dataframe"
+	"github.com/olekukonko/tablewriter"
+)
+
+// MapsEntry is a group of memory mappings with the same backing file.
+// Simplied from '/proc/$PID/maps'.
+type MapsEntry struct {
+	// Pathname is the backing file, or pseudo-path such as '[heap]'.
+	// Anonymous mappings are grouped into '[anonymous]'.
+	Pathname string
+
+	// Mappings is the number of address ranges.
+	Mappings uint64
+	// Perms is the distinct permissions of the ranges,
+	// such as 'r--p,r-xp'.
+	Perms string
+	// Size is the total size of the address ranges,
+	// not the resident memory.
+	Size string
+
+	// extra fields for sorting
+	SizeNum uint64
+}
+
+// MapsAnonymous is the pathname for anonymous mappings in 'MapsEntry'.
+const MapsAnonymous = "[anonymous]"
+
+// GetMaps reads '/proc/$PID/maps', and groups the mappings by
+// backing file, sorted by size in descending order.
+func GetMaps(pid int64, opts ...OpFunc) ([]MapsEntry, error) {
+	op := &EntryOp{}
+	op.applyOpts(opts)
+
+	ms, err := proc.GetMaps(pid)
+	if err != nil {
+		return nil, err
+	}
+	mss := GroupMaps(ms)
+
+	if op.TopLimit > 0 && len(mss) > op.TopLimit {
+		mss = mss[:op.TopLimit:op.TopLimit]
+	}
+	return mss, nil
+}
+
+// GroupMaps groups the mappings by backing file,
+// sorted by size in descending order.
+func GroupMaps(ms []proc.Map) []MapsEntry {
+	var (
+		paths []string
+		sizes = make(map[string]uint64)
+		cnts  = make(map[string]uint64)
+		perms = make(map[string]map[string]struct{})
+	)
+	for _, m := range ms {
+		p := m.Pathname
+		if p == "" {
+			p = MapsAnonymous
+		}
+		if _, ok := perms[p]; !ok {
+			paths = append(paths, p)
+			perms[p] = make(map[string]struct{})
+		}
+		sizes[p] += m.EndAddress - m.StartAddress
+		cnts[p]++
+		perms[p][m.Perms] = struct{}{}
+	}
+
+	mss := make([]MapsEntry, 0, len(paths))
+	for _, p := range paths {
+		ps := make([]string, 0, len(perms[p]))
+		for v := range perms[p] {
+			ps = append(ps, v)
+		}
+		sort.Strings(ps)
+		mss = append(mss, MapsEntry{
+			Pathname: p,
+			Mappings: cnts[p],
+			Perms:    strings.Join(ps, ","),
+			Size:     humanize.Bytes(sizes[p]),
+			SizeNum:  sizes[p],
+		})
+	}
+	sort.SliceStable(mss, func(i, j int) bool {
+		if mss[i].SizeNum != mss[j].SizeNum {
+			return mss[i].SizeNum > mss[j].SizeNum
+		}
+		return mss[i].Pathname < mss[j].Pathname
+	})
+	return mss
+}
+
+const columnsMapsToShow = 4
+
+var columnsMapsEntry = []string{
+	"PATHNAME",
+	"MAPPINGS",
+	"PERMS",
+	"SIZE",
+
+	// extra for sorting
+	"SIZE-NUM",
+}
+
+// ConvertMaps converts to rows.
+func ConvertMaps(mss ...MapsEntry) (header []string, rows [][]string) {
+	header = columnsMapsEntry
+	rows = make([][]string, len(mss))
+	for i, elem := range mss {
+		row := make([]string, len(columnsMapsEntry))
+		row[0] = elem.Pathname
+		row[1] = fmt.Sprintf("%d", elem.Mappings)
+		row[2] = elem.Perms
+		row[3] = elem.Size
+
+		row[4] = fmt.Sprintf("%d", elem.SizeNum)
+
+		rows[i] = row
+	}
+	dataframe.SortBy(
+		rows,
+		dataframe.Float64DescendingFunc(4), // SizeNum
+	).Sort(rows)
+
+	return
+}
+
+// StringMaps converts in print-friendly format.
+func StringMaps(header []string, rows [][]string, topLimit int) string {
+	buf := new(bytes.Buffer)
+	tw := tablewriter.NewWriter(buf)
+	tw.SetHeader(header[:columnsMapsToShow:columnsMapsToShow])
+
+	if topLimit > 0 && len(rows) > topLimit {
+		rows = rows[:topLimit:topLimit]
+	}
+
+	for _, row := range rows {
+		tw.Append(row[:columnsMapsToShow:columnsMapsToShow])
+	}
+	tw.SetAutoFormatHeaders(false)
+	tw.SetAlignment(tablewriter.ALIGN_RIGHT)
+	tw.Render()
+
+	return buf.String()
+}
@@ -0,0 +1,44 @@
+package inspect
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/gyuho/linux-inspect/proc"
+)
+
+func TestGroupMaps(t *testing.T) {
+	mss := GroupMaps([]proc.Map{
+		{StartAddress: 0x400000, EndAddress: 0x452000, Perms: "r-xp", Pathname: "/usr/bin/dbus-daemon"},
+		{StartAddress: 0x651000, EndAddress: 0x652000, Perms: "r--p", Pathname: "/usr/bin/dbus-daemon"},
+		{StartAddress: 0xe03000, EndAddress: 0xe24000, Perms: "rw-p", Pathname: "[heap]"},
+		{StartAddress: 0x7f1c3a200000, EndAddress: 0x7f1c3a600000, Perms: "rw-p"},
+		{StartAddress: 0x7f1c3a600000, EndAddress: 0x7f1c3a601000, Perms: "---p"},
+	})
+	if len(mss) != 3 {
+		t.Fatalf("len(mss) expected 3, got %d", len(mss))
+	}
+	expected := MapsEntry{Pathname: MapsAnonymous, Mappings: 2, Perms: "---p,rw-p", Size: "4.2 MB", SizeNum: 0x401000}
+	if mss[0] != expected {
+		t.Fatalf("expected %+v, got %+v", expected, mss[0])
+	}
+	if mss[1].Pathname != "/usr/bin/dbus-daemon" || mss[1].Mappings != 2 || mss[1].Perms != "r--p,r-xp" || mss[1].SizeNum != 0x53000 {
+		t.Fatalf("unexpected %+v", mss[1])
+	}
+	if mss[2].Pathname != "[heap]" || mss[2].SizeNum != 0x21000 {
+		t.Fatalf("unexpected %+v", mss[2])
+	}
+}
+
+func TestGetMaps(t *testing.T) {
+	mss, err := GetMaps(int64(os.Getpid()), WithTopLimit(5))
+	if err != nil {
+		t.Skip(err)
+	}
+	if len(mss) == 0 || len(mss) > 5 {
+		t.Fatalf("unexpected len(mss) %d", len(mss))
+	}
+	hd, rows := ConvertMaps(mss...)
+	fmt.Println(StringMaps(hd, rows, -1))
+}
@@ -0,0 +1,173 @@
+package inspect
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/gyuho/linux-inspect/proc"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+// MemEntry represents host memory statistics.
+// Simplied from 'Meminfo'.
+type MemEntry struct {
+	Total       string
+	Free        string
+	Available   string
+	UsedPercent float64
+
+	Buffers string
+	Cached  string
+
+	SwapTotal string
+	SwapFree  string
+
+	Dirty     string
+	Writeback string
+	Slab      string
+
+	CommitLimit string
+	CommittedAS string
+
+	HugePagesTotal uint64
+	HugePagesFree  uint64
+
+	// extra fields for sorting
+	TotalNum       uint64
+	FreeNum        uint64
+	AvailableNum   uint64
+	SwapTotalNum   uint64
+	SwapFreeNum    uint64
+	CommitLimitNum uint64
+	CommittedASNum uint64
+}
+
+// GetMem reads '/proc/meminfo' statistics.
+func GetMem() (MemEntry, error) {
+	m, err := proc.GetMeminfo()
+	if err != nil {
+		return MemEntry{}, err
+	}
+
+	// MemAvailable is only available in >= 3.14 kernels
+	avail := m.MemAvailableBytesN
+	if avail == 0 {
+		avail = m.MemFreeBytesN + m.BuffersBytesN + m.CachedBytesN
+	}
+	used := 0.0
+	if m.MemTotalBytesN > 0 && m.MemTotalBytesN >= avail {
+		used = float64(m.MemTotalBytesN-avail) / float64(m.MemTotalBytesN) * 100
+	}
+
+	return MemEntry{
+		Total:       m.MemTotalParsedBytes,
+		Free:        m.MemFreeParsedBytes,
+		Available:   m.MemAvailableParsedBytes,
+		UsedPercent: used,
+
+		Buffers: m.BuffersParsedBytes,
+		Cached:  m.CachedParsedBytes,
+
+		SwapTotal: m.SwapTotalParsedBytes,
+		SwapFree:  m.SwapFreeParsedBytes,
+
+		Dirty:     m.DirtyParsedBytes,
+		Writeback: m.WritebackParsedBytes,
+		Slab:      m.SlabParsedBytes,
+
+		CommitLimit: m.CommitLimitParsedBytes,
+		CommittedAS: m.CommittedASParsedBytes,
+
+		HugePagesTotal: m.HugePagesTotal,
+		HugePagesFree:  m.HugePagesFree,
+
+		TotalNum:       m.MemTotalBytesN,
+		FreeNum:        m.MemFreeBytesN,
+		AvailableNum:   avail,
+		SwapTotalNum:   m.SwapTotalBytesN,
+		SwapFreeNum:    m.SwapFreeBytesN,
+		CommitLimitNum: m.CommitLimitBytesN,
+		CommittedASNum: m.CommittedASBytesN,
+	}, nil
+}
+
+const columnsMemToShow = 15
+
+var columnsMemEntry = []string{
+	"TOTAL", "FREE", "AVAILABLE", "USED",
+	"BUFFERS", "CACHED",
+	"SWAP-TOTAL", "SWAP-FREE",
+	"DIRTY", "WRITEBACK", "SLAB",
+	"COMMIT-LIMIT", "COMMITTED-AS",
+	"HUGEPAGES-TOTAL", "HUGEPAGES-FREE",
+
+	// extra for sorting
+	"TOTAL-NUM",
+	"FREE-NUM",
+	"AVAILABLE-NUM",
+	"SWAP-TOTAL-NUM",
+	"SWAP-FREE-NUM",
+	"COMMIT-LIMIT-NUM",
+	"COMMITTED-AS-NUM",
+}
+
+// ConvertMem converts to rows.
+func ConvertMem(mss ...MemEntry) (header []string, rows [][]string) {
+	header = columnsMemEntry
+	rows = make([][]string, len(mss))
+	for i, elem := range mss {
+		row := make([]string, len(columnsMemEntry))
+		row[0] = elem.Total
+		row[1] = elem.Free
+		row[2] = elem.Available
+		row[3] = fmt.Sprintf("%3.2f %%", elem.UsedPercent)
+
+		row[4] = elem.Buffers
+		row[5] = elem.Cached
+
+		row[6] = elem.SwapTotal
+		row[7] = elem.SwapFree
+
+		row[8] = elem.Dirty
+		row[9] = elem.Writeback
+		row[10] = elem.Slab
+
+		row[11] = elem.CommitLimit
+		row[12] = elem.CommittedAS
+
+		row[13] = fmt.Sprintf("%d", elem.HugePagesTotal)
+		row[14] = fmt.Sprintf("%d", elem.HugePagesFree)
+
+		row[15] = fmt.Sprintf("%d", elem.TotalNum)
+		row[16] = fmt.Sprintf("%d", elem.FreeNum)
+		row[17] = fmt.Sprintf("%d", elem.AvailableNum)
+		row[18] = fmt.Sprintf("%d", elem.SwapTotalNum)
+		row[19] = fmt.Sprintf("%d", elem.SwapFreeNum)
+		row[20] = fmt.Sprintf("%d", elem.CommitLimitNum)
+		row[21] = fmt.Sprintf("%d", elem.CommittedASNum)
+
+		rows[i] = row
+	}
+	return
+}
+
+// StringMem converts in print-friendly format.
+func StringMem(header []string, rows [][]string, topLimit int) string {
+	buf := new(bytes.Buffer)
+	tw := tablewriter.NewWriter(buf)
+	tw.SetHeader(header[:columnsMemToShow:columnsMemToShow])
+
+	if topLimit > 0 && len(rows) > topLimit {
+		rows = rows[:topLimit:topLimit]
+	}
+
+	for _, row := range rows {
+		tw.Append(row[:columnsMemToShow:columnsMemToShow])
+	}
+	tw.SetAutoFormatHeaders(false)
+	tw.SetAlignment(tablewriter.ALIGN_RIGHT)
+	tw.Render()
+
+	return buf.String()
+}
@@ -0,0 +1,16 @@
+package inspect
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestGetMem(t *testing.T) {
+	m, err := GetMem()
+	if err != nil {
+		t.Fatal(err)
+	}
+	hd, rows := ConvertMem(m)
+	txt := StringMem(hd, rows, -1)
+	fmt.Println(txt)
+}
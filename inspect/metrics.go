@@ -0,0 +1,341 @@
+package inspect
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gyuho/linux-inspect/proc"
+)
+
+// MetricsContentType is the Content-Type of Prometheus text format.
+const MetricsContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// Collector collects 'PSEntry', 'DSEntry', 'NSEntry', load average,
+// uptime and socket states in Prometheus text exposition format.
+// Reference https://prometheus.io/docs/instrumenting/exposition_formats/.
+type Collector struct {
+	mu sync.Mutex
+
+	opts []OpFunc
+	op   EntryOp
+
+	diskDevice       string
+	networkInterface string
+
+	// reused across scrapes, so that CPU usage is
+	// computed since the previous scrape
+	sampler *CPUSampler
+}
+
+// NewCollector returns a new Collector. Processes and sockets are
+// filtered by 'opts' (e.g. 'WithProgram', 'WithPID'), disk stats by
+// 'diskDevice', and network stats by 'networkInterface', if not empty.
+func NewCollector(diskDevice, networkInterface string, opts ...OpFunc) *Collector {
+	c := &Collector{
+		opts:             opts,
+		diskDevice:       diskDevice,
+		networkInterface: networkInterface,
+		sampler:          NewCPUSampler(DefaultCPUSampleInterval),
+	}
+	c.op.applyOpts(opts)
+	return c
+}
+
+// ServeHTTP serves '/metrics'.
+func (c *Collector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	buf := new(bytes.Buffer)
+	if _, err := c.WriteTo(buf); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", MetricsContentType)
+	w.Write(buf.Bytes())
+}
+
+// WriteTo collects and writes all metrics. If a collector fails,
+// it is logged and reported in 'linux_inspect_scrape_error'.
+func (c *Collector) WriteTo(w io.Writer) (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var mfs []metricFamily
+	var errs []metricSample
+	for _, elem := range []struct {
+		name    string
+		collect func() ([]metricFamily, error)
+	}{
+		{"uptime", c.collectUptime},
+		{"loadavg", c.collectLoadAvg},
+		{"ps", c.collectPS},
+		{"ds", c.collectDS},
+		{"ns", c.collectNS},
+		{"ss", c.collectSS},
+	} {
+		fs, err := elem.collect()
+		v := 0.0
+		if err != nil {
+			log.Printf("%s collector error %v", elem.name, err)
+			v = 1
+		} else {
+			mfs = append(mfs, fs...)
+		}
+		errs = append(errs, metricSample{labels: []string{"collector", elem.name}, value: v})
+	}
+	mfs = append(mfs, metricFamily{
+		name:    "linux_inspect_scrape_error",
+		help:    "1 if the collector failed in the last scrape.",
+		tp:      "gauge",
+		samples: errs,
+	})
+
+	buf := new(bytes.Buffer)
+	for _, mf := range mfs {
+		mf.writeTo(buf)
+	}
+	return buf.WriteTo(w)
+}
+
+func (c *Collector) collectUptime() ([]metricFamily, error) {
+	u, err := proc.GetUptime()
+	if err != nil {
+		return nil, err
+	}
+	return []metricFamily{
+		{
+			name:    "linux_inspect_uptime_seconds",
+			help:    "Total uptime in seconds.",
+			tp:      "gauge",
+			samples: []metricSample{{value: u.UptimeTotal}},
+		},
+		{
+			name:    "linux_inspect_uptime_idle_seconds",
+			help:    "Total time spent in idle process in seconds.",
+			tp:      "gauge",
+			samples: []metricSample{{value: u.UptimeIdle}},
+		},
+	}, nil
+}
+
+func (c *Collector) collectLoadAvg() ([]metricFamily, error) {
+	l, err := proc.GetLoadAvg()
+	if err != nil {
+		return nil, err
+	}
+	return []metricFamily{
+		{
+			name: "linux_inspect_load_average",
+			help: "System load average.",
+			tp:   "gauge",
+			samples: []metricSample{
+				{labels: []string{"period", "1m"}, value: l.LoadAvg1Minute},
+				{labels: []string{"period", "5m"}, value: l.LoadAvg5Minute},
+				{labels: []string{"period", "15m"}, value: l.LoadAvg15Minute},
+			},
+		},
+		{
+			name:    "linux_inspect_scheduling_entities_runnable",
+			help:    "Number of currently runnable kernel scheduling entities.",
+			tp:      "gauge",
+			samples: []metricSample{{value: float64(l.RunnableKernelSchedulingEntities)}},
+		},
+		{
+			name:    "linux_inspect_scheduling_entities",
+			help:    "Number of kernel scheduling entities that currently exist.",
+			tp:      "gauge",
+			samples: []metricSample{{value: float64(l.CurrentKernelSchedulingEntities)}},
+		},
+	}, nil
+}
+
+func (c *Collector) collectPS() ([]metricFamily, error) {
+	opts := append([]OpFunc{}, c.opts...)
+	opts = append(opts, WithCPUSampler(c.sampler))
+	pss, err := GetPS(opts...)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(pss, func(i, j int) bool { return pss[i].PID < pss[j].PID })
+
+	mfs := []metricFamily{
+		{name: "linux_inspect_process_cpu_percent", help: "CPU usage of the process in percent, since the previous scrape.", tp: "gauge"},
+		{name: "linux_inspect_process_resident_memory_bytes", help: "Resident set size of the process in bytes.", tp: "gauge"},
+		{name: "linux_inspect_process_virtual_memory_bytes", help: "Virtual memory size of the process in bytes.", tp: "gauge"},
+		{name: "linux_inspect_process_open_fds", help: "Number of open file descriptors of the process.", tp: "gauge"},
+		{name: "linux_inspect_process_threads", help: "Number of threads of the process.", tp: "gauge"},
+		{name: "linux_inspect_process_voluntary_ctxt_switches_total", help: "Number of voluntary context switches of the process.", tp: "counter"},
+		{name: "linux_inspect_process_nonvoluntary_ctxt_switches_total", help: "Number of involuntary context switches of the process.", tp: "counter"},
+	}
+	pids := make([]int64, len(pss))
+	for i, p := range pss {
+		pids[i] = p.PID
+	}
+	fds := countOpenFDs(pids)
+
+	for _, p := range pss {
+		lb := []string{"pid", fmt.Sprintf("%d", p.PID), "program", p.Program}
+		for i, v := range []float64{
+			p.CPUNum,
+			float64(p.VMRSSNum),
+			float64(p.VMSizeNum),
+		} {
+			mfs[i].samples = append(mfs[i].samples, metricSample{labels: lb, value: v})
+		}
+		// skip processes whose '/proc/$PID/fd' is not readable
+		if n, ok := fds[p.PID]; ok {
+			mfs[3].samples = append(mfs[3].samples, metricSample{labels: lb, value: float64(n)})
+		}
+		for i, v := range []float64{
+			float64(p.Threads),
+			float64(p.VoluntaryCtxtSwitches),
+			float64(p.NonvoluntaryCtxtSwitches),
+		} {
+			mfs[4+i].samples = append(mfs[4+i].samples, metricSample{labels: lb, value: v})
+		}
+	}
+	return mfs, nil
+}
+
+func (c *Collector) collectDS() ([]metricFamily, error) {
+	dss, err := GetDS()
+	if err != nil {
+		return nil, err
+	}
+
+	mfs := []metricFamily{
+		{name: "linux_inspect_disk_reads_completed_total", help: "Number of reads completed successfully.", tp: "counter"},
+		{name: "linux_inspect_disk_sectors_read_total", help: "Number of sectors read successfully.", tp: "counter"},
+		{name: "linux_inspect_disk_read_time_seconds_total", help: "Time spent reading in seconds.", tp: "counter"},
+		{name: "linux_inspect_disk_writes_completed_total", help: "Number of writes completed successfully.", tp: "counter"},
+		{name: "linux_inspect_disk_sectors_written_total", help: "Number of sectors written successfully.", tp: "counter"},
+		{name: "linux_inspect_disk_write_time_seconds_total", help: "Time spent writing in seconds.", tp: "counter"},
+	}
+	for _, d := range dss {
+		if c.diskDevice != "" && d.Device != c.diskDevice {
+			continue
+		}
+		lb := []string{"device", d.Device}
+		for i, v := range []float64{
+			float64(d.ReadsCompleted),
+			float64(d.SectorsRead),
+			float64(d.TimeSpentOnReadingMs) / 1000,
+			float64(d.WritesCompleted),
+			float64(d.SectorsWritten),
+			float64(d.TimeSpentOnWritingMs) / 1000,
+		} {
+			mfs[i].samples = append(mfs[i].samples, metricSample{labels: lb, value: v})
+		}
+	}
+	return mfs, nil
+}
+
+func (c *Collector) collectNS() ([]metricFamily, error) {
+	nss, err := GetNS()
+	if err != nil {
+		return nil, err
+	}
+
+	mfs := []metricFamily{
+		{name: "linux_inspect_network_receive_bytes_total", help: "Number of bytes received.", tp: "counter"},
+		{name: "linux_inspect_network_receive_packets_total", help: "Number of packets received.", tp: "counter"},
+		{name: "linux_inspect_network_transmit_bytes_total", help: "Number of bytes transmitted.", tp: "counter"},
+		{name: "linux_inspect_network_transmit_packets_total", help: "Number of packets transmitted.", tp: "counter"},
+	}
+	for _, n := range nss {
+		if c.networkInterface != "" && n.Interface != c.networkInterface {
+			continue
+		}
+		lb := []string{"interface", n.Interface}
+		for i, v := range []float64{
+			float64(n.ReceiveBytesNum),
+			float64(n.ReceivePackets),
+			float64(n.TransmitBytesNum),
+			float64(n.TransmitPackets),
+		} {
+			mfs[i].samples = append(mfs[i].samples, metricSample{labels: lb, value: v})
+		}
+	}
+	return mfs, nil
+}
+
+func (c *Collector) collectSS() ([]metricFamily, error) {
+	opts := append([]OpFunc{}, c.opts...)
+	opts = append(opts, WithTCP(), WithTCP6(), WithUDP(), WithUDP6())
+	if c.op.PID < 1 && c.op.ProgramMatchFunc == nil && !c.op.hasProcessMatch() {
+		opts = append(opts, WithUnowned())
+	}
+	sss, err := GetSS(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// a socket shared by multiple processes is counted once
+	seen := make(map[string]struct{})
+	counts := make(map[[2]string]int)
+	for _, s := range sss {
+		k := ssEntryKey(s)
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		counts[[2]string{s.Protocol, s.State}]++
+	}
+	keys := make([][2]string, 0, len(counts))
+	for k := range counts {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i][0] != keys[j][0] {
+			return keys[i][0] < keys[j][0]
+		}
+		return keys[i][1] < keys[j][1]
+	})
+
+	mf := metricFamily{name: "linux_inspect_sockets", help: "Number of sockets by protocol and state.", tp: "gauge"}
+	for _, k := range keys {
+		mf.samples = append(mf.samples, metricSample{labels: []string{"protocol", k[0], "state", k[1]}, value: float64(counts[k])})
+	}
+	return []metricFamily{mf}, nil
+}
+
+type metricFamily struct {
+	name    string
+	help    string
+	tp      string
+	samples []metricSample
+}
+
+type metricSample struct {
+	// labels are name, value pairs
+	labels []string
+	value  float64
+}
+
+func (mf metricFamily) writeTo(buf *bytes.Buffer) {
+	fmt.Fprintf(buf, "# HELP %s %s\n", mf.name, mf.help)
+	fmt.Fprintf(buf, "# TYPE %s %s\n", mf.name, mf.tp)
+	for _, s := range mf.samples {
+		buf.WriteString(mf.name)
+		if len(s.labels) > 0 {
+			buf.WriteByte('{')
+			for i := 0; i+1 < len(s.labels); i += 2 {
+				if i > 0 {
+					buf.WriteByte(',')
+				}
+				fmt.Fprintf(buf, "%s=\"%s\"", s.labels[i], labelEscaper.Replace(s.labels[i+1]))
+			}
+			buf.WriteByte('}')
+		}
+		buf.WriteByte(' ')
+		buf.WriteString(strconv.FormatFloat(s.value, 'g', -1, 64))
+		buf.WriteByte('\n')
+	}
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
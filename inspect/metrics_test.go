@@ -0,0 +1,65 @@
+package inspect
+
+import (
+	"bytes"
+	"fmt"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestCollector(t *testing.T) {
+	pid := os.Getpid()
+	c := NewCollector("", "lo", WithPID(int64(pid)))
+
+	srv := httptest.NewServer(c)
+	defer srv.Close()
+
+	resp, err := srv.Client().Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if ct := resp.Header.Get("Content-Type"); ct != MetricsContentType {
+		t.Fatalf("Content-Type expected %q, got %q", MetricsContentType, ct)
+	}
+	buf := new(bytes.Buffer)
+	if _, err = buf.ReadFrom(resp.Body); err != nil {
+		t.Fatal(err)
+	}
+	txt := buf.String()
+
+	for _, s := range []string{
+		"# TYPE linux_inspect_uptime_seconds gauge\n",
+		`linux_inspect_load_average{period="15m"} `,
+		fmt.Sprintf(`linux_inspect_process_resident_memory_bytes{pid="%d",program="inspect.test"} `, pid),
+		fmt.Sprintf(`linux_inspect_process_open_fds{pid="%d",program="inspect.test"} `, pid),
+		`linux_inspect_network_receive_bytes_total{interface="lo"} `,
+		"# TYPE linux_inspect_sockets gauge\n",
+		`linux_inspect_scrape_error{collector="ps"} 0`,
+	} {
+		if !strings.Contains(txt, s) {
+			t.Fatalf("expected %q in\n%s", s, txt)
+		}
+	}
+	if strings.Contains(txt, `linux_inspect_network_receive_bytes_total{interface="eth0"}`) {
+		t.Fatalf("unexpected interface in\n%s", txt)
+	}
+}
+
+func TestMetricFamilyEscape(t *testing.T) {
+	mf := metricFamily{
+		name:    "test_metric",
+		help:    "Test.",
+		tp:      "gauge",
+		samples: []metricSample{{labels: []string{"program", "a\"b\\c\n"}, value: 1.5}},
+	}
+	buf := new(bytes.Buffer)
+	mf.writeTo(buf)
+
+	exp := "# HELP test_metric Test.\n# TYPE test_metric gauge\ntest_metric{program=\"a\\\"b\\\\c\\n\"} 1.5\n"
+	if buf.String() != exp {
+		t.Fatalf("expected %q, got %q", exp, buf.String())
+	}
+}
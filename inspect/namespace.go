@@ -0,0 +1,128 @@
+package inspect
+
+import (
+	"log"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/gyuho/linux-inspect/proc"
+)
+
+// NamespaceEntry is a namespace and the processes in it.
+type NamespaceEntry struct {
+	// Type is the namespace type (e.g. 'net').
+	Type string
+	// Inode is the namespace inode ID in '/proc/$PID/ns/$TYPE'.
+	Inode uint64
+	// PIDs are the processes in the namespace, in ascending order.
+	PIDs []int64
+}
+
+// GetNamespaceInventory groups all processes by their cgroup, ipc,
+// mnt, net, pid, user and uts namespaces, sorted by type and then
+// by the number of processes in descending order. Processes whose
+// namespaces are not readable (e.g. other users' processes without
+// root permission) are skipped.
+func GetNamespaceInventory() ([]NamespaceEntry, error) {
+	pids, err := proc.ListPIDs()
+	if err != nil {
+		return nil, err
+	}
+	nsm := getNamespaces(pids)
+
+	type key struct {
+		tp    string
+		inode uint64
+	}
+	groups := make(map[key][]int64)
+	for pid, ns := range nsm {
+		for _, tp := range proc.NamespaceTypes {
+			inode := ns.Get(tp)
+			if inode == 0 {
+				// not supported by the kernel
+				continue
+			}
+			k := key{tp, inode}
+			groups[k] = append(groups[k], pid)
+		}
+	}
+
+	es := make([]NamespaceEntry, 0, len(groups))
+	for k, ps := range groups {
+		sort.Slice(ps, func(i, j int) bool { return ps[i] < ps[j] })
+		es = append(es, NamespaceEntry{Type: k.tp, Inode: k.inode, PIDs: ps})
+	}
+	sort.Slice(es, func(i, j int) bool {
+		if es[i].Type != es[j].Type {
+			return es[i].Type < es[j].Type
+		}
+		if len(es[i].PIDs) != len(es[j].PIDs) {
+			return len(es[i].PIDs) > len(es[j].PIDs)
+		}
+		return es[i].Inode < es[j].Inode
+	})
+	return es, nil
+}
+
+// getNamespaces reads the namespaces of the PIDs, skipping
+// the ones that are not readable or have exited.
+func getNamespaces(pids []int64) map[int64]proc.Namespaces {
+	var mu sync.Mutex
+	nsm := make(map[int64]proc.Namespaces, len(pids))
+
+	var wg sync.WaitGroup
+	limitc := make(chan struct{}, maxConcurrentProcFDLimit)
+	wg.Add(len(pids))
+	for _, pid := range pids {
+		go func(pid int64) {
+			defer func() {
+				<-limitc
+				wg.Done()
+			}()
+			limitc <- struct{}{}
+
+			ns, err := proc.GetNamespaces(pid)
+			if err != nil {
+				if !os.IsPermission(err) && !os.IsNotExist(err) {
+					log.Printf("proc.GetNamespaces error %v for PID %d", err, pid)
+				}
+				return
+			}
+			mu.Lock()
+			nsm[pid] = ns
+			mu.Unlock()
+		}(pid)
+	}
+	wg.Wait()
+	return nsm
+}
+
+// netNamespacePIDs picks the lowest PID in each network namespace,
+// since socket tables and network devices in '/proc/$PID/net' are
+// per network namespace. It maps each picked PID to the network
+// namespace inode ID. PIDs whose namespace is not readable (e.g.
+// other users' processes without root permission) are skipped,
+// since they can't be told apart from each other.
+func netNamespacePIDs(pids []int64) map[int64]uint64 {
+	nsm := getNamespaces(pids)
+
+	sorted := make([]int64, len(pids))
+	copy(sorted, pids)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	seen := make(map[uint64]struct{})
+	picked := make(map[int64]uint64)
+	for _, pid := range sorted {
+		ns, ok := nsm[pid]
+		if !ok || ns.Net == 0 {
+			continue
+		}
+		if _, ok = seen[ns.Net]; ok {
+			continue
+		}
+		seen[ns.Net] = struct{}{}
+		picked[pid] = ns.Net
+	}
+	return picked
+}
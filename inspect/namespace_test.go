@@ -0,0 +1,42 @@
+package inspect
+
+import (
+	"os"
+	"testing"
+
+	"github.com/gyuho/linux-inspect/proc"
+)
+
+func TestGetNamespaceInventory(t *testing.T) {
+	es, err := GetNamespaceInventory()
+	if err != nil {
+		t.Skip(err)
+	}
+	ns, err := proc.GetNamespaces(int64(os.Getpid()))
+	if err != nil || ns.Net == 0 {
+		t.Skip(err)
+	}
+	for _, e := range es {
+		if e.Type != proc.NamespaceNet || e.Inode != ns.Net {
+			continue
+		}
+		for _, pid := range e.PIDs {
+			if pid == int64(os.Getpid()) {
+				return
+			}
+		}
+	}
+	t.Fatalf("PID %d not found in net namespace %d", os.Getpid(), ns.Net)
+}
+
+func TestNetNamespacePIDs(t *testing.T) {
+	pid := int64(os.Getpid())
+	ns, err := proc.GetNamespaces(pid)
+	if err != nil || ns.Net == 0 {
+		t.Skip(err)
+	}
+	nets := netNamespacePIDs([]int64{pid, pid})
+	if len(nets) != 1 || nets[pid] != ns.Net {
+		t.Fatalf("unexpected net namespaces %v", nets)
+	}
+}
@@ -3,34 +3,105 @@ package inspect
 import (
 	"bytes"
 	"fmt"
+	"log"
+	"os"
+	"sort"
+	"time"
 
 	"github.com/gyuho/linux-inspect/proc"
+	"github.com/gyuho/linux-inspect/sysfs"
 
+	humanize "github.com/dustin/go-humanize"
 	"github.com/gyuho/dataframe"
 	"github.com/olekukonko/tablewriter"
 )
 
 // NSEntry represents network statistics.
-// Simplied from 'NetDev'.
+// Simplied from 'NetDev', joined with '/sys/class/net'.
 type NSEntry struct {
 	Interface string
 
+	// link fields from '/sys/class/net', only set for interfaces
+	// in the network namespace of the current process
+	State string
+	MTU   uint64
+	Speed string
+
 	ReceiveBytes    string
 	ReceivePackets  uint64
 	TransmitBytes   string
 	TransmitPackets uint64
 
+	// NetNamespace is the network namespace inode ID,
+	// only set with 'WithPID' or 'WithAllNetNamespaces'.
+	NetNamespace uint64
+
 	// extra fields for sorting
 	ReceiveBytesNum  uint64
 	TransmitBytesNum uint64
+
+	// SpeedNum is the link speed in bits per second,
+	// zero if unknown (e.g. 'lo', 'veth', 'dummy').
+	SpeedNum uint64
 }
 
-// GetNS lists all '/proc/net/dev' statistics.
-func GetNS() ([]NSEntry, error) {
+// GetNS lists all '/proc/net/dev' statistics, in the network namespace
+// of the current process. With 'WithPID', it reads '/proc/$PID/net/dev'
+// in the network namespace of the PID. With 'WithAllNetNamespaces',
+// it reads once per network namespace of all processes.
+func GetNS(opts ...OpFunc) ([]NSEntry, error) {
+	op := &EntryOp{}
+	op.applyOpts(opts)
+
+	switch {
+	case op.AllNetNamespaces:
+		pids, err := proc.ListPIDs()
+		if err != nil {
+			return nil, err
+		}
+		var ds []NSEntry
+		for pid, inode := range netNamespacePIDs(pids) {
+			ss, err := proc.GetNetDevByPID(pid)
+			if err != nil {
+				// process may have exited
+				log.Printf("proc.GetNetDevByPID error %v for PID %d", err, pid)
+				continue
+			}
+			ds = append(ds, convertNetDev(ss, inode)...)
+		}
+		joinNetInterfaces(ds, false)
+		sort.Slice(ds, func(i, j int) bool {
+			if ds[i].NetNamespace != ds[j].NetNamespace {
+				return ds[i].NetNamespace < ds[j].NetNamespace
+			}
+			return ds[i].Interface < ds[j].Interface
+		})
+		return ds, nil
+
+	case op.PID > 0:
+		ss, err := proc.GetNetDevByPID(op.PID)
+		if err != nil {
+			return nil, err
+		}
+		ns, err := proc.GetNamespaces(op.PID)
+		if err != nil {
+			return nil, err
+		}
+		ds := convertNetDev(ss, ns.Net)
+		joinNetInterfaces(ds, false)
+		return ds, nil
+	}
+
 	ss, err := proc.GetNetDev()
 	if err != nil {
 		return nil, err
 	}
+	ds := convertNetDev(ss, 0)
+	joinNetInterfaces(ds, true)
+	return ds, nil
+}
+
+func convertNetDev(ss []proc.NetDev, netNamespace uint64) []NSEntry {
 	ds := make([]NSEntry, len(ss))
 	for i := range ss {
 		ds[i] = NSEntry{
@@ -41,15 +112,58 @@ func GetNS() ([]NSEntry, error) {
 			TransmitBytes:   ss[i].TransmitBytesParsedBytes,
 			TransmitPackets: ss[i].TransmitPackets,
 
+			NetNamespace: netNamespace,
+
 			ReceiveBytesNum:  ss[i].ReceiveBytesBytesN,
 			TransmitBytesNum: ss[i].TransmitBytesBytesN,
 		}
 	}
-	return ds, nil
+	return ds
+}
+
+// joinNetInterfaces fills link fields from '/sys/class/net', which
+// only lists interfaces in the network namespace of the current process.
+// If not 'inCurrent', only the entries whose 'NetNamespace' is known to
+// be the same as the current process are joined.
+func joinNetInterfaces(ds []NSEntry, inCurrent bool) {
+	ifaces, err := sysfs.ListNetInterfaces()
+	if err != nil {
+		log.Printf("sysfs.ListNetInterfaces error %v", err)
+		return
+	}
+	im := make(map[string]sysfs.NetInterface, len(ifaces))
+	for _, n := range ifaces {
+		im[n.Name] = n
+	}
+	var self proc.Namespaces
+	if !inCurrent {
+		if self, err = proc.GetNamespaces(int64(os.Getpid())); err != nil {
+			log.Printf("proc.GetNamespaces error %v", err)
+			return
+		}
+	}
+
+	for i := range ds {
+		if !inCurrent && (ds[i].NetNamespace == 0 || ds[i].NetNamespace != self.Net) {
+			continue
+		}
+		n, ok := im[ds[i].Interface]
+		if !ok {
+			continue
+		}
+		ds[i].State = n.OperState
+		ds[i].MTU = n.MTU
+		ds[i].Speed = "unknown"
+		if n.Speed != sysfs.SpeedUnknown {
+			ds[i].SpeedNum = uint64(n.Speed) * 1000000
+			ds[i].Speed = humanize.SI(float64(ds[i].SpeedNum), "b/s")
+		}
+	}
 }
 
-const columnsNSToShow = 5
+const columnsNSToShow = 8
 
+// columnsNSEntry are the columns in 'ProcHeader'.
 var columnsNSEntry = []string{
 	"INTERFACE",
 
@@ -61,45 +175,259 @@ var columnsNSEntry = []string{
 	"TRANSMIT-BYTES-NUM",
 }
 
+// columnsNSLinkEntry adds link columns to 'columnsNSEntry',
+// which are not in 'ProcHeader' to keep the CSV format.
+var columnsNSLinkEntry = []string{
+	"INTERFACE",
+	"STATE", "MTU", "SPEED",
+
+	"RECEIVE-BYTES", "RECEIVE-PACKETS",
+	"TRANSMIT-BYTES", "TRANSMIT-PACKETS",
+
+	// extra for sorting
+	"RECEIVE-BYTES-NUM",
+	"TRANSMIT-BYTES-NUM",
+	"SPEED-NUM",
+}
+
+// columnNetNamespace is prepended to the columns of 'ConvertNS' and
+// 'ConvertNSRate' when any entry has 'NetNamespace', so that the same
+// interface (e.g. 'eth0') in different network namespaces can be told apart.
+const columnNetNamespace = "NET-NAMESPACE"
+
 // ConvertNS converts to rows.
 func ConvertNS(nss ...NSEntry) (header []string, rows [][]string) {
-	header = columnsNSEntry
+	withNetNamespace := false
+	for _, elem := range nss {
+		if elem.NetNamespace != 0 {
+			withNetNamespace = true
+			break
+		}
+	}
+
+	header = columnsNSLinkEntry
 	rows = make([][]string, len(nss))
 	for i, elem := range nss {
-		row := make([]string, len(columnsNSEntry))
+		row := make([]string, len(columnsNSLinkEntry))
 		row[0] = elem.Interface
+		row[1] = elem.State
+		row[2] = fmt.Sprintf("%d", elem.MTU)
+		row[3] = elem.Speed
 
-		row[1] = elem.ReceiveBytes
-		row[2] = fmt.Sprintf("%d", elem.ReceivePackets)
-		row[3] = elem.TransmitBytes
-		row[4] = fmt.Sprintf("%d", elem.TransmitPackets)
+		row[4] = elem.ReceiveBytes
+		row[5] = fmt.Sprintf("%d", elem.ReceivePackets)
+		row[6] = elem.TransmitBytes
+		row[7] = fmt.Sprintf("%d", elem.TransmitPackets)
 
-		row[5] = fmt.Sprintf("%d", elem.ReceiveBytesNum)
-		row[6] = fmt.Sprintf("%d", elem.TransmitBytesNum)
+		row[8] = fmt.Sprintf("%d", elem.ReceiveBytesNum)
+		row[9] = fmt.Sprintf("%d", elem.TransmitBytesNum)
+		row[10] = fmt.Sprintf("%d", elem.SpeedNum)
 
+		if withNetNamespace {
+			row = append([]string{fmt.Sprintf("%d", elem.NetNamespace)}, row...)
+		}
 		rows[i] = row
 	}
+	offset := 0
+	if withNetNamespace {
+		header = append([]string{columnNetNamespace}, header...)
+		offset = 1
+	}
 	dataframe.SortBy(
 		rows,
-		dataframe.Float64DescendingFunc(5), // ReceiveBytesNum
-		dataframe.Float64DescendingFunc(6), // TransmitBytesNum
+		dataframe.Float64DescendingFunc(offset+8), // ReceiveBytesNum
+		dataframe.Float64DescendingFunc(offset+9), // TransmitBytesNum
 	).Sort(rows)
 
 	return
 }
 
+// columnsToShow returns the number of columns to show,
+// including 'columnNetNamespace' if in the header.
+func columnsToShow(header []string, n int) int {
+	if len(header) > 0 && header[0] == columnNetNamespace {
+		return n + 1
+	}
+	return n
+}
+
 // StringNS converts in print-friendly format.
 func StringNS(header []string, rows [][]string, topLimit int) string {
+	n := columnsToShow(header, columnsNSToShow)
+
+	buf := new(bytes.Buffer)
+	tw := tablewriter.NewWriter(buf)
+	tw.SetHeader(header[:n:n])
+
+	if topLimit > 0 && len(rows) > topLimit {
+		rows = rows[:topLimit:topLimit]
+	}
+
+	for _, row := range rows {
+		tw.Append(row[:n:n])
+	}
+	tw.SetAutoFormatHeaders(false)
+	tw.SetAlignment(tablewriter.ALIGN_RIGHT)
+	tw.Render()
+
+	return buf.String()
+}
+
+// NSRateEntry represents per-second network statistics
+// between two 'NSEntry' snapshots.
+type NSRateEntry struct {
+	Interface string
+
+	// NetNamespace is the network namespace inode ID,
+	// same as 'NSEntry'.
+	NetNamespace uint64
+
+	ReceiveBytesPerSecond    string
+	ReceivePacketsPerSecond  float64
+	TransmitBytesPerSecond   string
+	TransmitPacketsPerSecond float64
+
+	// utilization as a percentage of link speed,
+	// "-" if the link speed is unknown
+	ReceiveUtilization  string
+	TransmitUtilization string
+
+	// extra fields for sorting
+	ReceiveBytesPerSecondNum  uint64
+	TransmitBytesPerSecondNum uint64
+	ReceiveUtilizationNum     float64
+	TransmitUtilizationNum    float64
+}
+
+// GetNSRate computes per-second rates of each interface in both
+// 'prev' and 'cur', taken 'elapsed' apart. Interfaces are matched
+// by the network namespace and the name, since the same name
+// (e.g. 'eth0') can be in multiple network namespaces.
+func GetNSRate(prev, cur []NSEntry, elapsed time.Duration) []NSRateEntry {
+	if elapsed <= 0 {
+		return nil
+	}
+	sec := elapsed.Seconds()
+
+	type key struct {
+		netNamespace uint64
+		iface        string
+	}
+	pm := make(map[key]NSEntry, len(prev))
+	for _, elem := range prev {
+		pm[key{elem.NetNamespace, elem.Interface}] = elem
+	}
+	var rs []NSRateEntry
+	for _, elem := range cur {
+		p, ok := pm[key{elem.NetNamespace, elem.Interface}]
+		if !ok {
+			continue
+		}
+		rxBytes := uint64(float64(counterDelta(p.ReceiveBytesNum, elem.ReceiveBytesNum)) / sec)
+		txBytes := uint64(float64(counterDelta(p.TransmitBytesNum, elem.TransmitBytesNum)) / sec)
+		r := NSRateEntry{
+			Interface:    elem.Interface,
+			NetNamespace: elem.NetNamespace,
+
+			ReceiveBytesPerSecond:    humanize.Bytes(rxBytes) + "/s",
+			ReceivePacketsPerSecond:  float64(counterDelta(p.ReceivePackets, elem.ReceivePackets)) / sec,
+			TransmitBytesPerSecond:   humanize.Bytes(txBytes) + "/s",
+			TransmitPacketsPerSecond: float64(counterDelta(p.TransmitPackets, elem.TransmitPackets)) / sec,
+
+			ReceiveUtilization:  "-",
+			TransmitUtilization: "-",
+
+			ReceiveBytesPerSecondNum:  rxBytes,
+			TransmitBytesPerSecondNum: txBytes,
+		}
+		if elem.SpeedNum > 0 {
+			r.ReceiveUtilizationNum = float64(rxBytes*8) / float64(elem.SpeedNum) * 100
+			r.TransmitUtilizationNum = float64(txBytes*8) / float64(elem.SpeedNum) * 100
+			r.ReceiveUtilization = fmt.Sprintf("%3.2f %%", r.ReceiveUtilizationNum)
+			r.TransmitUtilization = fmt.Sprintf("%3.2f %%", r.TransmitUtilizationNum)
+		}
+		rs = append(rs, r)
+	}
+	return rs
+}
+
+const columnsNSRateToShow = 7
+
+var columnsNSRateEntry = []string{
+	"INTERFACE",
+
+	"RECEIVE-BYTES/S", "RECEIVE-PACKETS/S",
+	"TRANSMIT-BYTES/S", "TRANSMIT-PACKETS/S",
+	"RECEIVE-UTILIZATION", "TRANSMIT-UTILIZATION",
+
+	// extra for sorting
+	"RECEIVE-BYTES/S-NUM",
+	"TRANSMIT-BYTES/S-NUM",
+	"RECEIVE-UTILIZATION-NUM",
+	"TRANSMIT-UTILIZATION-NUM",
+}
+
+// ConvertNSRate converts to rows.
+func ConvertNSRate(nss ...NSRateEntry) (header []string, rows [][]string) {
+	withNetNamespace := false
+	for _, elem := range nss {
+		if elem.NetNamespace != 0 {
+			withNetNamespace = true
+			break
+		}
+	}
+
+	header = columnsNSRateEntry
+	rows = make([][]string, len(nss))
+	for i, elem := range nss {
+		row := make([]string, len(columnsNSRateEntry))
+		row[0] = elem.Interface
+
+		row[1] = elem.ReceiveBytesPerSecond
+		row[2] = fmt.Sprintf("%.2f", elem.ReceivePacketsPerSecond)
+		row[3] = elem.TransmitBytesPerSecond
+		row[4] = fmt.Sprintf("%.2f", elem.TransmitPacketsPerSecond)
+		row[5] = elem.ReceiveUtilization
+		row[6] = elem.TransmitUtilization
+
+		row[7] = fmt.Sprintf("%d", elem.ReceiveBytesPerSecondNum)
+		row[8] = fmt.Sprintf("%d", elem.TransmitBytesPerSecondNum)
+		row[9] = fmt.Sprintf("%.2f", elem.ReceiveUtilizationNum)
+		row[10] = fmt.Sprintf("%.2f", elem.TransmitUtilizationNum)
+
+		if withNetNamespace {
+			row = append([]string{fmt.Sprintf("%d", elem.NetNamespace)}, row...)
+		}
+		rows[i] = row
+	}
+	offset := 0
+	if withNetNamespace {
+		header = append([]string{columnNetNamespace}, header...)
+		offset = 1
+	}
+	dataframe.SortBy(
+		rows,
+		dataframe.Float64DescendingFunc(offset+7), // ReceiveBytesPerSecond
+		dataframe.Float64DescendingFunc(offset+8), // TransmitBytesPerSecond
+	).Sort(rows)
+
+	return
+}
+
+// StringNSRate converts in print-friendly format.
+func StringNSRate(header []string, rows [][]string, topLimit int) string {
+	n := columnsToShow(header, columnsNSRateToShow)
+
 	buf := new(bytes.Buffer)
 	tw := tablewriter.NewWriter(buf)
-	tw.SetHeader(header[:columnsNSToShow:columnsNSToShow])
+	tw.SetHeader(header[:n:n])
 
 	if topLimit > 0 && len(rows) > topLimit {
 		rows = rows[:topLimit:topLimit]
 	}
 
 	for _, row := range rows {
-		tw.Append(row[:columnsNSToShow:columnsNSToShow])
+		tw.Append(row[:n:n])
 	}
 	tw.SetAutoFormatHeaders(false)
 	tw.SetAlignment(tablewriter.ALIGN_RIGHT)
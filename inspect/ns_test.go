@@ -2,7 +2,11 @@ package inspect
 
 import (
 	"fmt"
+	"os"
 	"testing"
+	"time"
+
+	"github.com/gyuho/linux-inspect/sysfs"
 )
 
 func TestGetNS(t *testing.T) {
@@ -14,3 +18,118 @@ func TestGetNS(t *testing.T) {
 	txt := StringNS(hd, rows, -1)
 	fmt.Println(txt)
 }
+
+func TestGetNSRate(t *testing.T) {
+	prev := []NSEntry{{Interface: "eth0", ReceiveBytesNum: 1000, ReceivePackets: 10, TransmitBytesNum: 500, TransmitPackets: 5}}
+	cur := []NSEntry{{Interface: "eth0", ReceiveBytesNum: 3000, ReceivePackets: 30, TransmitBytesNum: 500, TransmitPackets: 5}}
+	rs := GetNSRate(prev, cur, 500*time.Millisecond)
+	if len(rs) != 1 {
+		t.Fatalf("expected 1 entry, got %+v", rs)
+	}
+	if rs[0].ReceiveBytesPerSecondNum != 4000 || rs[0].ReceivePacketsPerSecond != 40 || rs[0].TransmitBytesPerSecondNum != 0 {
+		t.Fatalf("unexpected rate %+v", rs[0])
+	}
+	if rs[0].ReceiveBytesPerSecond != "4.0 kB/s" {
+		t.Fatalf("expected '4.0 kB/s', got %q", rs[0].ReceiveBytesPerSecond)
+	}
+	if rs[0].ReceiveUtilization != "-" {
+		t.Fatalf("expected '-' for unknown link speed, got %q", rs[0].ReceiveUtilization)
+	}
+	hd, rows := ConvertNSRate(rs...)
+	txt := StringNSRate(hd, rows, -1)
+	fmt.Println(txt)
+}
+
+func TestGetNSRateNetNamespaces(t *testing.T) {
+	prev := []NSEntry{
+		{Interface: "eth0", NetNamespace: 1, ReceiveBytesNum: 1000},
+		{Interface: "eth0", NetNamespace: 2, ReceiveBytesNum: 5000},
+	}
+	cur := []NSEntry{
+		{Interface: "eth0", NetNamespace: 2, ReceiveBytesNum: 6000},
+		{Interface: "eth0", NetNamespace: 1, ReceiveBytesNum: 3000},
+		{Interface: "eth0", NetNamespace: 3, ReceiveBytesNum: 9000},
+	}
+	rs := GetNSRate(prev, cur, time.Second)
+	if len(rs) != 2 {
+		t.Fatalf("expected 2 entries, got %+v", rs)
+	}
+	if rs[0].NetNamespace != 2 || rs[0].ReceiveBytesPerSecondNum != 1000 {
+		t.Fatalf("unexpected rate %+v", rs[0])
+	}
+	if rs[1].NetNamespace != 1 || rs[1].ReceiveBytesPerSecondNum != 2000 {
+		t.Fatalf("unexpected rate %+v", rs[1])
+	}
+	hd, rows := ConvertNSRate(rs...)
+	if hd[0] != columnNetNamespace || rows[0][0] != "1" || rows[0][1] != "eth0" {
+		t.Fatalf("unexpected header %q, rows %q", hd, rows)
+	}
+	txt := StringNSRate(hd, rows, -1)
+	fmt.Println(txt)
+
+	hd, rows = ConvertNS(cur...)
+	if hd[0] != columnNetNamespace || rows[0][0] != "3" || rows[0][1] != "eth0" {
+		t.Fatalf("unexpected header %q, rows %q", hd, rows)
+	}
+	txt = StringNS(hd, rows, -1)
+	fmt.Println(txt)
+}
+
+func TestGetNSWithPID(t *testing.T) {
+	ns, err := GetNS(WithPID(int64(os.Getpid())))
+	if err != nil {
+		t.Skip(err)
+	}
+	for _, e := range ns {
+		if e.NetNamespace == 0 {
+			t.Fatalf("expected network namespace, got %+v", e)
+		}
+	}
+}
+
+func TestGetNSRateUtilization(t *testing.T) {
+	// 100 Mb/s link, 2.5 MB/s received (20 Mb/s)
+	prev := []NSEntry{{Interface: "eth0", SpeedNum: 100000000}}
+	cur := []NSEntry{{Interface: "eth0", SpeedNum: 100000000, ReceiveBytesNum: 5000000, TransmitBytesNum: 125000}}
+	rs := GetNSRate(prev, cur, 2*time.Second)
+	if len(rs) != 1 {
+		t.Fatalf("expected 1 entry, got %+v", rs)
+	}
+	if rs[0].ReceiveUtilizationNum != 20 || rs[0].TransmitUtilizationNum != 0.5 {
+		t.Fatalf("unexpected utilization %+v", rs[0])
+	}
+	if rs[0].ReceiveUtilization != "20.00 %" || rs[0].TransmitUtilization != "0.50 %" {
+		t.Fatalf("unexpected utilization %q, %q", rs[0].ReceiveUtilization, rs[0].TransmitUtilization)
+	}
+}
+
+func TestGetNSLink(t *testing.T) {
+	ns, err := GetNS()
+	if err != nil {
+		t.Skip(err)
+	}
+	for _, e := range ns {
+		if e.Interface == "lo" && (e.MTU == 0 || e.Speed != "unknown" || e.SpeedNum != 0) {
+			t.Fatalf("unexpected 'lo' link %+v", e)
+		}
+	}
+}
+
+func TestJoinNetInterfaces(t *testing.T) {
+	ifaces, err := sysfs.ListNetInterfaces()
+	if err != nil || len(ifaces) == 0 {
+		t.Skip(err)
+	}
+
+	// unknown network namespace is not joined
+	ds := []NSEntry{{Interface: ifaces[0].Name}}
+	joinNetInterfaces(ds, false)
+	if ds[0].State != "" {
+		t.Fatalf("expected no link fields, got %+v", ds[0])
+	}
+
+	joinNetInterfaces(ds, true)
+	if ds[0].State != ifaces[0].OperState || ds[0].MTU != ifaces[0].MTU {
+		t.Fatalf("expected link fields of %+v, got %+v", ifaces[0], ds[0])
+	}
+}
@@ -0,0 +1,108 @@
+package inspect
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+// NstatEntry represents a network protocol counter between two
+// 'proc.GetNetCounters' snapshots, as in 'nstat'.
+type NstatEntry struct {
+	// Name is the counter name (e.g. 'TcpRetransSegs', 'TcpExtListenOverflows').
+	Name string
+
+	Total     uint64
+	Delta     uint64
+	PerSecond float64
+}
+
+// nstatGauges are values in '/proc/net/snmp' that are not counters.
+var nstatGauges = map[string]struct{}{
+	"IpForwarding":    {},
+	"IpDefaultTTL":    {},
+	"IpReasmTimeout":  {},
+	"TcpRtoAlgorithm": {},
+	"TcpRtoMin":       {},
+	"TcpRtoMax":       {},
+	"TcpCurrEstab":    {},
+}
+
+// GetNstat computes per-interval deltas and per-second rates of each
+// counter in both 'prev' and 'cur', taken 'elapsed' apart. As in 'nstat',
+// counters that did not change and gauges (e.g. 'TcpCurrEstab') are
+// skipped. Entries are sorted by name.
+func GetNstat(prev, cur map[string]uint64, elapsed time.Duration) []NstatEntry {
+	if elapsed <= 0 {
+		return nil
+	}
+	sec := elapsed.Seconds()
+
+	var es []NstatEntry
+	for name, v := range cur {
+		if _, ok := nstatGauges[name]; ok {
+			continue
+		}
+		p, ok := prev[name]
+		if !ok {
+			continue
+		}
+		delta := counterDelta(p, v)
+		if delta == 0 {
+			continue
+		}
+		es = append(es, NstatEntry{
+			Name:      name,
+			Total:     v,
+			Delta:     delta,
+			PerSecond: float64(delta) / sec,
+		})
+	}
+	sort.Slice(es, func(i, j int) bool { return es[i].Name < es[j].Name })
+	return es
+}
+
+const columnsNstatToShow = 4
+
+var columnsNstatEntry = []string{
+	"NAME",
+	"TOTAL", "DELTA", "PER-SECOND",
+}
+
+// ConvertNstat converts to rows.
+func ConvertNstat(es ...NstatEntry) (header []string, rows [][]string) {
+	header = columnsNstatEntry
+	rows = make([][]string, len(es))
+	for i, elem := range es {
+		row := make([]string, len(columnsNstatEntry))
+		row[0] = elem.Name
+		row[1] = fmt.Sprintf("%d", elem.Total)
+		row[2] = fmt.Sprintf("%d", elem.Delta)
+		row[3] = fmt.Sprintf("%.2f", elem.PerSecond)
+		rows[i] = row
+	}
+	return
+}
+
+// StringNstat converts in print-friendly format.
+func StringNstat(header []string, rows [][]string, topLimit int) string {
+	buf := new(bytes.Buffer)
+	tw := tablewriter.NewWriter(buf)
+	tw.SetHeader(header[:columnsNstatToShow:columnsNstatToShow])
+
+	if topLimit > 0 && len(rows) > topLimit {
+		rows = rows[:topLimit:topLimit]
+	}
+
+	for _, row := range rows {
+		tw.Append(row[:columnsNstatToShow:columnsNstatToShow])
+	}
+	tw.SetAutoFormatHeaders(false)
+	tw.SetAlignment(tablewriter.ALIGN_RIGHT)
+	tw.Render()
+
+	return buf.String()
+}
@@ -0,0 +1,42 @@
+package inspect
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestGetNstat(t *testing.T) {
+	prev := map[string]uint64{
+		"TcpRetransSegs":        8745,
+		"TcpCurrEstab":          41,
+		"TcpExtListenOverflows": 214,
+		"UdpRcvbufErrors":       1024,
+		"UdpInDatagrams":        100,
+	}
+	cur := map[string]uint64{
+		"TcpRetransSegs":        8845,
+		"TcpCurrEstab":          52,
+		"TcpExtListenOverflows": 214,
+		"UdpRcvbufErrors":       1074,
+		"UdpInDatagrams":        90, // reset
+		"Udp6RcvbufErrors":      3,  // not in 'prev'
+	}
+	es := GetNstat(prev, cur, 2*time.Second)
+	if len(es) != 2 {
+		t.Fatalf("expected 2 entries, got %+v", es)
+	}
+	if es[0].Name != "TcpRetransSegs" || es[0].Total != 8845 || es[0].Delta != 100 || es[0].PerSecond != 50 {
+		t.Fatalf("unexpected entry %+v", es[0])
+	}
+	if es[1].Name != "UdpRcvbufErrors" || es[1].Delta != 50 || es[1].PerSecond != 25 {
+		t.Fatalf("unexpected entry %+v", es[1])
+	}
+	if GetNstat(prev, cur, 0) != nil {
+		t.Fatal("expected no entries with zero elapsed")
+	}
+
+	hd, rows := ConvertNstat(es...)
+	txt := StringNstat(hd, rows, -1)
+	fmt.Println(txt)
+}
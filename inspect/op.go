@@ -2,8 +2,10 @@ package inspect
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 
+	"github.com/gyuho/linux-inspect/proc"
 	"github.com/gyuho/linux-inspect/top"
 )
 
@@ -15,20 +17,39 @@ type EntryOp struct {
 	PID      int64
 	TopLimit int
 
+	// for ns, ss
+	AllNetNamespaces bool
+
+	// for ps, ss
+	CmdlineMatch *regexp.Regexp
+	ExePath      string
+
 	// for ss
 	TCP        bool
 	TCP6       bool
+	UDP        bool
+	UDP6       bool
+	Raw        bool
+	Raw6       bool
+	Unix       bool
+	Unowned    bool
+	Netlink    bool
 	LocalPort  int64
 	RemotePort int64
 
 	// for ps
 	TopExecPath string
 	TopStream   *top.Stream
+	CPUSampler  *CPUSampler
+	SortBy      string
+	Cgroup      string
+	Smaps       bool
 
 	// for Proc
 	DiskDevice       string
 	NetworkInterface string
 	ExtraPath        string
+	Pressure         bool
 }
 
 // OpFunc applies each filter.
@@ -54,6 +75,33 @@ func WithPID(pid int64) OpFunc {
 	return func(op *EntryOp) { op.PID = pid }
 }
 
+// WithCmdlineMatch to filter entries by the full command line
+// in '/proc/$PID/cmdline', with arguments joined by spaces.
+// Use this to tell apart processes with the same program name
+// (e.g. 'java -jar a.jar' and 'java -jar b.jar').
+func WithCmdlineMatch(re *regexp.Regexp) OpFunc {
+	return func(op *EntryOp) { op.CmdlineMatch = re }
+}
+
+// WithExePath to filter entries by the executable path
+// in '/proc/$PID/exe' (e.g. '/usr/bin/python3').
+func WithExePath(path string) OpFunc {
+	return func(op *EntryOp) { op.ExePath = path }
+}
+
+// WithCgroup to filter entries by cgroup path prefix
+// (e.g. '/kubepods/burstable/pod$UID'), container ID prefix
+// (e.g. 12-character short Docker ID), or pod UID.
+func WithCgroup(cgroup string) OpFunc {
+	return func(op *EntryOp) { op.Cgroup = cgroup }
+}
+
+// WithAllNetNamespaces to read network statistics
+// once per network namespace of all processes.
+func WithAllNetNamespaces() OpFunc {
+	return func(op *EntryOp) { op.AllNetNamespaces = true }
+}
+
 // WithTopLimit to filter entries with limit.
 func WithTopLimit(limit int) OpFunc {
 	return func(op *EntryOp) { op.TopLimit = limit }
@@ -81,7 +129,47 @@ func WithTCP6() OpFunc {
 	return func(op *EntryOp) { op.TCP6 = true }
 }
 
+// WithUDP to filter entries by UDP.
+func WithUDP() OpFunc {
+	return func(op *EntryOp) { op.UDP = true }
+}
+
+// WithUDP6 to filter entries by UDP6.
+func WithUDP6() OpFunc {
+	return func(op *EntryOp) { op.UDP6 = true }
+}
+
+// WithRaw to filter entries by raw IPv4 sockets.
+func WithRaw() OpFunc {
+	return func(op *EntryOp) { op.Raw = true }
+}
+
+// WithRaw6 to filter entries by raw IPv6 sockets.
+func WithRaw6() OpFunc {
+	return func(op *EntryOp) { op.Raw6 = true }
+}
+
+// WithUnix to filter entries by UNIX domain sockets.
+func WithUnix() OpFunc {
+	return func(op *EntryOp) { op.Unix = true }
+}
+
+// WithUnowned includes sockets that no process holds
+// a file descriptor of (e.g. TIME_WAIT), with PID 0.
+func WithUnowned() OpFunc {
+	return func(op *EntryOp) { op.Unowned = true }
+}
+
+// WithNetlink reads TCP and UDP sockets with 'NETLINK_SOCK_DIAG',
+// which also reports 'tcp_info' (e.g. RTT, congestion window).
+// It falls back to '/proc' when netlink is not available.
+func WithNetlink() OpFunc {
+	return func(op *EntryOp) { op.Netlink = true }
+}
+
 // WithTopExecPath configures 'top' command path.
+// If not empty, CPU usage is read from 'top' command output
+// instead of sampling '/proc'.
 func WithTopExecPath(path string) OpFunc {
 	return func(op *EntryOp) { op.TopExecPath = path }
 }
@@ -91,6 +179,25 @@ func WithTopStream(str *top.Stream) OpFunc {
 	return func(op *EntryOp) { op.TopStream = str }
 }
 
+// WithCPUSampler computes CPU usage with the given sampler,
+// so that it can be reused across calls.
+func WithCPUSampler(s *CPUSampler) OpFunc {
+	return func(op *EntryOp) { op.CPUSampler = s }
+}
+
+// WithSortBy sorts the entries by the key (e.g. 'PSSortByPSS')
+// in descending order, before applying 'WithTopLimit'.
+func WithSortBy(key string) OpFunc {
+	return func(op *EntryOp) { op.SortBy = key }
+}
+
+// WithSmaps reads PSS and USS from '/proc/$PID/smaps_rollup',
+// which is expensive for processes with many mappings. It is
+// implied when sorting by 'PSSortByPSS' or 'PSSortByUSS'.
+func WithSmaps(enabled bool) OpFunc {
+	return func(op *EntryOp) { op.Smaps = enabled }
+}
+
 // WithDiskDevice to filter entries by disk device.
 func WithDiskDevice(name string) OpFunc {
 	return func(op *EntryOp) { op.DiskDevice = name }
@@ -106,6 +213,11 @@ func WithExtraPath(path string) OpFunc {
 	return func(op *EntryOp) { op.ExtraPath = path }
 }
 
+// WithPressure to read the pressure stall information.
+func WithPressure(enabled bool) OpFunc {
+	return func(op *EntryOp) { op.Pressure = enabled }
+}
+
 // applyOpts panics when op.Program != "" && op.PID > 0.
 func (op *EntryOp) applyOpts(opts []OpFunc) {
 	for _, of := range opts {
@@ -113,22 +225,83 @@ func (op *EntryOp) applyOpts(opts []OpFunc) {
 	}
 
 	if op.DiskDevice != "" || op.NetworkInterface != "" || op.ExtraPath != "" {
-		if (op.program != "" || op.ProgramMatchFunc != nil) || op.TopLimit > 0 || op.LocalPort > 0 || op.RemotePort > 0 || op.TCP || op.TCP6 {
+		if (op.program != "" || op.ProgramMatchFunc != nil) || op.TopLimit > 0 || op.LocalPort > 0 || op.RemotePort > 0 || len(op.protocols()) > 0 {
 			panic(fmt.Errorf("not-valid Proc fileter; disk device %q or network interface %q or extra path %q", op.DiskDevice, op.NetworkInterface, op.ExtraPath))
 		}
 	}
 	if (op.program != "" || op.ProgramMatchFunc != nil) && op.PID > 0 {
 		panic(fmt.Errorf("can't filter both by program(%q or %p) and PID(%d)", op.program, op.ProgramMatchFunc, op.PID))
 	}
-	if !op.TCP && !op.TCP6 {
-		// choose both
+	if len(op.protocols()) == 0 {
+		// choose both TCP, TCP6
 		op.TCP, op.TCP6 = true, true
 	}
 	if op.LocalPort > 0 && op.RemotePort > 0 {
 		panic(fmt.Errorf("can't query by both local(%d) and remote(%d) ports", op.LocalPort, op.RemotePort))
 	}
+}
+
+// matchProcess returns true if the command line and executable
+// path match 'WithCmdlineMatch' and 'WithExePath'.
+func (op *EntryOp) matchProcess(args []string, exe string) bool {
+	if op.CmdlineMatch != nil && !op.CmdlineMatch.MatchString(strings.Join(args, " ")) {
+		return false
+	}
+	if op.ExePath != "" && exe != op.ExePath {
+		return false
+	}
+	return true
+}
+
+// matchCgroup returns true if the cgroup path or container
+// matches 'WithCgroup'.
+func (op *EntryOp) matchCgroup(cgroup string, c Container) bool {
+	if op.Cgroup == "" {
+		return true
+	}
+	if cgroup != "" && hasPathPrefix(cgroup, op.Cgroup) {
+		return true
+	}
+	if c.ID != "" && strings.HasPrefix(c.ID, op.Cgroup) {
+		return true
+	}
+	return c.PodUID != "" && c.PodUID == op.Cgroup
+}
+
+// hasPathPrefix returns true if the path is the prefix or under it,
+// so that '/kubepods/pod1' does not match '/kubepods/pod12'.
+func hasPathPrefix(p, prefix string) bool {
+	prefix = strings.TrimSuffix(prefix, "/")
+	return p == prefix || strings.HasPrefix(p, prefix+"/")
+}
+
+// hasProcessMatch returns true if filtered by 'WithCmdlineMatch' or 'WithExePath'.
+func (op *EntryOp) hasProcessMatch() bool {
+	return op.CmdlineMatch != nil || op.ExePath != ""
+}
 
-	if op.TopExecPath == "" {
-		op.TopExecPath = top.DefaultExecPath
+// protocols returns the selected socket protocols.
+func (op *EntryOp) protocols() (tps []proc.TransportProtocol) {
+	if op.TCP {
+		tps = append(tps, proc.TypeTCP)
+	}
+	if op.TCP6 {
+		tps = append(tps, proc.TypeTCP6)
+	}
+	if op.UDP {
+		tps = append(tps, proc.TypeUDP)
+	}
+	if op.UDP6 {
+		tps = append(tps, proc.TypeUDP6)
+	}
+	if op.Raw {
+		tps = append(tps, proc.TypeRaw)
+	}
+	if op.Raw6 {
+		tps = append(tps, proc.TypeRaw6)
+	}
+	if op.Unix {
+		tps = append(tps, proc.TypeUnix)
 	}
+	return tps
 }
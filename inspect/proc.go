@@ -21,6 +21,10 @@ type Proc struct {
 
 	LoadAvg proc.LoadAvg
 
+	// Pressure is the system-wide pressure stall information,
+	// only set with 'WithPressure' (Linux 4.20+).
+	Pressure proc.SystemPressure
+
 	DSEntry              DSEntry
 	ReadsCompletedDelta  uint64
 	SectorsReadDelta     uint64
@@ -71,7 +75,7 @@ func nanoToUnix(unixNano int64) (unixSec int64) {
 
 // GetProc returns current 'Proc' data.
 // PID is required.
-// Disk device, network interface, extra path are optional.
+// Disk device, network interface, extra path, pressure are optional.
 func GetProc(opts ...OpFunc) (Proc, error) {
 	op := &EntryOp{}
 	op.applyOpts(opts)
@@ -88,7 +92,7 @@ func GetProc(opts ...OpFunc) (Proc, error) {
 	toFinish++
 	go func() {
 		// get process stats
-		ets, err := GetPS(WithPID(op.PID), WithTopStream(op.TopStream))
+		ets, err := GetPS(WithPID(op.PID), WithTopStream(op.TopStream), WithCPUSampler(op.CPUSampler))
 		if err != nil {
 			errc <- err
 			return
@@ -112,6 +116,19 @@ func GetProc(opts ...OpFunc) (Proc, error) {
 		errc <- nil
 	}()
 
+	if op.Pressure {
+		toFinish++
+		go func() {
+			sp, err := proc.GetPressure()
+			if err != nil {
+				errc <- err
+				return
+			}
+			pc.Pressure = sp
+			errc <- nil
+		}()
+	}
+
 	if op.DiskDevice != "" {
 		toFinish++
 		go func() {
@@ -197,8 +214,41 @@ var (
 
 	// ProcHeaderIndex maps each Proc column name to its index in row.
 	ProcHeaderIndex = make(map[string]int)
+
+	// ProcHeaderWithPressure lists all Proc CSV columns with
+	// the PSI columns, when read with 'WithPressure'.
+	ProcHeaderWithPressure []string
+
+	// ProcHeaderWithPressureIndex maps each Proc column name
+	// to its index in row, with the PSI columns.
+	ProcHeaderWithPressureIndex = make(map[string]int)
 )
 
+// columnsPressure are the PSI columns in 'ProcHeaderWithPressure', such
+// as 'PSI-CPU-SOME-AVG10', in the same order as 'pressureFields'.
+var columnsPressure []string
+
+func init() {
+	for _, resource := range []string{"CPU", "MEMORY", "IO"} {
+		for _, line := range []string{"SOME", "FULL"} {
+			for _, avg := range []string{"AVG10", "AVG60", "AVG300"} {
+				columnsPressure = append(columnsPressure, fmt.Sprintf("PSI-%s-%s-%s", resource, line, avg))
+			}
+		}
+	}
+}
+
+// pressureFields returns the pointers to the PSI averages,
+// in the same order as 'columnsPressure'.
+func (p *Proc) pressureFields() (fields []*float64) {
+	for _, r := range []*proc.Pressure{&p.Pressure.CPU, &p.Pressure.Memory, &p.Pressure.IO} {
+		for _, s := range []*proc.PressureStat{&r.Some, &r.Full} {
+			fields = append(fields, &s.Avg10, &s.Avg60, &s.Avg300)
+		}
+	}
+	return fields
+}
+
 func init() {
 	// more columns to 'ProcHeader'
 	ProcHeader = append(ProcHeader,
@@ -225,19 +275,40 @@ func init() {
 		"TRANSMIT-PACKETS-DELTA",
 		"RECEIVE-BYTES-NUM-DELTA",
 		"TRANSMIT-BYTES-NUM-DELTA",
-
-		"EXTRA",
 	)
+	ProcHeader = append(ProcHeader, "EXTRA")
+
+	// PSI columns after 'EXTRA', so that the other columns
+	// are in the same place with or without 'WithPressure'
+	ProcHeaderWithPressure = append(ProcHeader[:len(ProcHeader):len(ProcHeader)], columnsPressure...)
 
 	for i, v := range ProcHeader {
 		ProcHeaderIndex[v] = i
 	}
+	for i, v := range ProcHeaderWithPressure {
+		ProcHeaderWithPressureIndex[v] = i
+	}
+}
+
+// ToRow converts 'Proc' to string slice, in the order of 'ProcHeader'.
+func (p *Proc) ToRow() []string {
+	return p.toRow(false)
+}
+
+// ToRowWithPressure converts 'Proc' to string slice,
+// in the order of 'ProcHeaderWithPressure'.
+func (p *Proc) ToRowWithPressure() []string {
+	return p.toRow(true)
 }
 
-// ToRow converts 'Proc' to string slice.
+// toRow converts 'Proc' to string slice.
 // Make sure to change this whenever 'Proc' fields are updated.
-func (p *Proc) ToRow() (row []string) {
-	row = make([]string, len(ProcHeader))
+func (p *Proc) toRow(pressure bool) (row []string) {
+	if pressure {
+		row = make([]string, len(ProcHeaderWithPressure))
+	} else {
+		row = make([]string, len(ProcHeader))
+	}
 	row[0] = fmt.Sprintf("%d", p.UnixNanosecond) // UNIX-NANOSECOND
 	row[1] = fmt.Sprintf("%d", p.UnixSecond)     // UNIX-SECOND
 
@@ -248,54 +319,65 @@ func (p *Proc) ToRow() (row []string) {
 	row[6] = p.PSEntry.CPU                           // CPU
 	row[7] = p.PSEntry.VMRSS                         // VMRSS
 	row[8] = p.PSEntry.VMSize                        // VMSIZE
-	row[9] = fmt.Sprintf("%d", p.PSEntry.FD)         // FD
-	row[10] = fmt.Sprintf("%d", p.PSEntry.Threads)   // THREADS
-	row[11] = fmt.Sprintf("%d", p.PSEntry.Threads)   // VOLUNTARY-CTXT-SWITCHES
-	row[12] = fmt.Sprintf("%d", p.PSEntry.Threads)   // NON-VOLUNTARY-CTXT-SWITCHES
-	row[13] = fmt.Sprintf("%3.2f", p.PSEntry.CPUNum) // CPU-NUM
-	row[14] = fmt.Sprintf("%d", p.PSEntry.VMRSSNum)  // VMRSS-NUM
-	row[15] = fmt.Sprintf("%d", p.PSEntry.VMSizeNum) // VMSIZE-NUM
-
-	row[16] = fmt.Sprintf("%3.2f", p.LoadAvg.LoadAvg1Minute)  // LOAD-AVERAGE-1-MINUTE
-	row[17] = fmt.Sprintf("%3.2f", p.LoadAvg.LoadAvg5Minute)  // LOAD-AVERAGE-5-MINUTE
-	row[18] = fmt.Sprintf("%3.2f", p.LoadAvg.LoadAvg15Minute) // LOAD-AVERAGE-15-MINUTE
-
-	row[19] = p.DSEntry.Device                                  // DEVICE
-	row[20] = fmt.Sprintf("%d", p.DSEntry.ReadsCompleted)       // READS-COMPLETED
-	row[21] = fmt.Sprintf("%d", p.DSEntry.SectorsRead)          // SECTORS-READ
-	row[22] = p.DSEntry.TimeSpentOnReading                      // TIME(READS)
-	row[23] = fmt.Sprintf("%d", p.DSEntry.WritesCompleted)      // WRITES-COMPLETED
-	row[24] = fmt.Sprintf("%d", p.DSEntry.SectorsWritten)       // SECTORS-WRITTEN
-	row[25] = p.DSEntry.TimeSpentOnWriting                      // TIME(WRITES)
-	row[26] = fmt.Sprintf("%d", p.DSEntry.TimeSpentOnReadingMs) // MILLISECONDS(READS)
-	row[27] = fmt.Sprintf("%d", p.DSEntry.TimeSpentOnWritingMs) // MILLISECONDS(WRITES)
-
-	row[28] = p.NSEntry.Interface                           // INTERFACE
-	row[29] = p.NSEntry.ReceiveBytes                        // RECEIVE-BYTES
-	row[30] = fmt.Sprintf("%d", p.NSEntry.ReceivePackets)   // RECEIVE-PACKETS
-	row[31] = p.NSEntry.TransmitBytes                       // TRANSMIT-BYTES
-	row[32] = fmt.Sprintf("%d", p.NSEntry.TransmitPackets)  // TRANSMIT-PACKETS
-	row[33] = fmt.Sprintf("%d", p.NSEntry.ReceiveBytesNum)  // RECEIVE-BYTES-NUM
-	row[34] = fmt.Sprintf("%d", p.NSEntry.TransmitBytesNum) // TRANSMIT-BYTES-NUM
-
-	row[35] = fmt.Sprintf("%d", p.ReadsCompletedDelta)  // READS-COMPLETED-DELTA
-	row[36] = fmt.Sprintf("%d", p.SectorsReadDelta)     // SECTORS-READ-DELTA
-	row[37] = fmt.Sprintf("%d", p.WritesCompletedDelta) // WRITES-COMPLETED-DELTA
-	row[38] = fmt.Sprintf("%d", p.SectorsWrittenDelta)  // SECTORS-WRITTEN-DELTA
-
-	row[39] = fmt.Sprintf("%d", p.ReadBytesDelta)      // READ-BYTES-DELTA
-	row[40] = fmt.Sprintf("%d", p.ReadMegabytesDelta)  // READ-MEGABYTES-DELTA
-	row[41] = fmt.Sprintf("%d", p.WriteBytesDelta)     // WRITE-BYTES-DELTA
-	row[42] = fmt.Sprintf("%d", p.WriteMegabytesDelta) // WRITE-MEGABYTES-DELTA
-
-	row[43] = p.ReceiveBytesDelta                        // RECEIVE-BYTES-DELTA
-	row[44] = fmt.Sprintf("%d", p.ReceivePacketsDelta)   // RECEIVE-PACKETS-DELTA
-	row[45] = p.TransmitBytesDelta                       // TRANSMIT-BYTES-DELTA
-	row[46] = fmt.Sprintf("%d", p.TransmitPacketsDelta)  // TRANSMIT-PACKETS-DELTA
-	row[47] = fmt.Sprintf("%d", p.ReceiveBytesNumDelta)  // RECEIVE-BYTES-NUM-DELTA
-	row[48] = fmt.Sprintf("%d", p.TransmitBytesNumDelta) // TRANSMIT-BYTES-NUM-DELTA
-
-	row[49] = string(p.Extra) // EXTRA
+	row[9] = p.PSEntry.PSS                           // PSS
+	row[10] = p.PSEntry.USS                          // USS
+	row[11] = fmt.Sprintf("%d", p.PSEntry.FD)        // FD
+	row[12] = fmt.Sprintf("%d", p.PSEntry.Threads)   // THREADS
+	row[13] = fmt.Sprintf("%d", p.PSEntry.Threads)   // VOLUNTARY-CTXT-SWITCHES
+	row[14] = fmt.Sprintf("%d", p.PSEntry.Threads)   // NON-VOLUNTARY-CTXT-SWITCHES
+	row[15] = p.PSEntry.containerName()              // CONTAINER
+	row[16] = fmt.Sprintf("%3.2f", p.PSEntry.CPUNum) // CPU-NUM
+	row[17] = fmt.Sprintf("%d", p.PSEntry.VMRSSNum)  // VMRSS-NUM
+	row[18] = fmt.Sprintf("%d", p.PSEntry.VMSizeNum) // VMSIZE-NUM
+	row[19] = fmt.Sprintf("%d", p.PSEntry.PSSNum)    // PSS-NUM
+	row[20] = fmt.Sprintf("%d", p.PSEntry.USSNum)    // USS-NUM
+
+	row[21] = fmt.Sprintf("%3.2f", p.LoadAvg.LoadAvg1Minute)  // LOAD-AVERAGE-1-MINUTE
+	row[22] = fmt.Sprintf("%3.2f", p.LoadAvg.LoadAvg5Minute)  // LOAD-AVERAGE-5-MINUTE
+	row[23] = fmt.Sprintf("%3.2f", p.LoadAvg.LoadAvg15Minute) // LOAD-AVERAGE-15-MINUTE
+
+	row[24] = p.DSEntry.Device                                  // DEVICE
+	row[25] = fmt.Sprintf("%d", p.DSEntry.ReadsCompleted)       // READS-COMPLETED
+	row[26] = fmt.Sprintf("%d", p.DSEntry.SectorsRead)          // SECTORS-READ
+	row[27] = p.DSEntry.TimeSpentOnReading                      // TIME(READS)
+	row[28] = fmt.Sprintf("%d", p.DSEntry.WritesCompleted)      // WRITES-COMPLETED
+	row[29] = fmt.Sprintf("%d", p.DSEntry.SectorsWritten)       // SECTORS-WRITTEN
+	row[30] = p.DSEntry.TimeSpentOnWriting                      // TIME(WRITES)
+	row[31] = fmt.Sprintf("%d", p.DSEntry.TimeSpentOnReadingMs) // MILLISECONDS(READS)
+	row[32] = fmt.Sprintf("%d", p.DSEntry.TimeSpentOnWritingMs) // MILLISECONDS(WRITES)
+
+	row[33] = p.NSEntry.Interface                           // INTERFACE
+	row[34] = p.NSEntry.ReceiveBytes                        // RECEIVE-BYTES
+	row[35] = fmt.Sprintf("%d", p.NSEntry.ReceivePackets)   // RECEIVE-PACKETS
+	row[36] = p.NSEntry.TransmitBytes                       // TRANSMIT-BYTES
+	row[37] = fmt.Sprintf("%d", p.NSEntry.TransmitPackets)  // TRANSMIT-PACKETS
+	row[38] = fmt.Sprintf("%d", p.NSEntry.ReceiveBytesNum)  // RECEIVE-BYTES-NUM
+	row[39] = fmt.Sprintf("%d", p.NSEntry.TransmitBytesNum) // TRANSMIT-BYTES-NUM
+
+	row[40] = fmt.Sprintf("%d", p.ReadsCompletedDelta)  // READS-COMPLETED-DELTA
+	row[41] = fmt.Sprintf("%d", p.SectorsReadDelta)     // SECTORS-READ-DELTA
+	row[42] = fmt.Sprintf("%d", p.WritesCompletedDelta) // WRITES-COMPLETED-DELTA
+	row[43] = fmt.Sprintf("%d", p.SectorsWrittenDelta)  // SECTORS-WRITTEN-DELTA
+
+	row[44] = fmt.Sprintf("%d", p.ReadBytesDelta)      // READ-BYTES-DELTA
+	row[45] = fmt.Sprintf("%d", p.ReadMegabytesDelta)  // READ-MEGABYTES-DELTA
+	row[46] = fmt.Sprintf("%d", p.WriteBytesDelta)     // WRITE-BYTES-DELTA
+	row[47] = fmt.Sprintf("%d", p.WriteMegabytesDelta) // WRITE-MEGABYTES-DELTA
+
+	row[48] = p.ReceiveBytesDelta                        // RECEIVE-BYTES-DELTA
+	row[49] = fmt.Sprintf("%d", p.ReceivePacketsDelta)   // RECEIVE-PACKETS-DELTA
+	row[50] = p.TransmitBytesDelta                       // TRANSMIT-BYTES-DELTA
+	row[51] = fmt.Sprintf("%d", p.TransmitPacketsDelta)  // TRANSMIT-PACKETS-DELTA
+	row[52] = fmt.Sprintf("%d", p.ReceiveBytesNumDelta)  // RECEIVE-BYTES-NUM-DELTA
+	row[53] = fmt.Sprintf("%d", p.TransmitBytesNumDelta) // TRANSMIT-BYTES-NUM-DELTA
+
+	row[54] = string(p.Extra) // EXTRA
+
+	if pressure {
+		for i, v := range p.pressureFields() {
+			row[55+i] = fmt.Sprintf("%3.2f", *v) // PSI-*
+		}
+	}
 
 	return
 }
@@ -31,11 +31,20 @@ type CSV struct {
 	// ExtraPath contains extra information.
 	ExtraPath string
 
+	// Pressure is true to collect the pressure stall information
+	// in '/proc/pressure' on each 'Add' (Linux 4.20+), and to save
+	// with the columns in 'ProcHeaderWithPressure'.
+	Pressure bool
+
 	// TopStream feeds realtime 'top' command data in the background, every second.
 	// And whenver 'Add' gets called, returns the latest 'top' data.
 	// Use this to provide more accurate CPU usage.
 	TopStream *top.Stream
 
+	// CPUSampler computes CPU usage from '/proc' between each 'Add' call,
+	// when 'TopStream' is not configured.
+	CPUSampler *CPUSampler
+
 	// Rows are sorted by unix time in nanoseconds.
 	// It's the number of nanoseconds (not seconds) elapsed
 	// since January 1, 1970 UTC.
@@ -63,6 +72,8 @@ func NewCSV(fpath string, pid int64, diskDevice string, networkInterface string,
 	}
 	if tcfg != nil {
 		c.TopStream, err = tcfg.StartStream()
+	} else {
+		c.CPUSampler = NewCPUSampler(DefaultCPUSampleInterval)
 	}
 	return
 }
@@ -76,7 +87,9 @@ func (c *CSV) Add() error {
 		WithDiskDevice(c.DiskDevice),
 		WithNetworkInterface(c.NetworkInterface),
 		WithExtraPath(c.ExtraPath),
+		WithPressure(c.Pressure),
 		WithTopStream(c.TopStream),
+		WithCPUSampler(c.CPUSampler),
 	)
 	if err != nil {
 		return err
@@ -146,6 +159,11 @@ func (c *CSV) Save() error {
 	}
 	defer f.Close()
 
+	c.Header, c.HeaderIndex = ProcHeader, ProcHeaderIndex
+	if c.Pressure {
+		c.Header, c.HeaderIndex = ProcHeaderWithPressure, ProcHeaderWithPressureIndex
+	}
+
 	wr := csv.NewWriter(f)
 	if err := wr.Write(c.Header); err != nil {
 		return err
@@ -153,7 +171,7 @@ func (c *CSV) Save() error {
 
 	rows := make([][]string, len(c.Rows))
 	for i, row := range c.Rows {
-		rows[i] = row.ToRow()
+		rows[i] = row.toRow(c.Pressure)
 	}
 	if err := wr.WriteAll(rows); err != nil {
 		return err
@@ -163,7 +181,9 @@ func (c *CSV) Save() error {
 	return wr.Error()
 }
 
-// ReadCSV reads a CSV file and convert to 'CSV'.
+// ReadCSV reads a CSV file and convert to 'CSV'. Columns are read
+// by the header of the file, and columns that are not in the file
+// (e.g. written by an older version) are zero.
 // Make sure to change this whenever 'Proc' fields are updated.
 func ReadCSV(fpath string) (*CSV, error) {
 	f, err := fileutil.OpenToRead(fpath)
@@ -188,6 +208,12 @@ func ReadCSV(fpath string) (*CSV, error) {
 		return nil, fmt.Errorf("expected header at top, got %+v", rows[0])
 	}
 
+	header := rows[0]
+	headerIndex := make(map[string]int, len(header))
+	for i, v := range header {
+		headerIndex[v] = i
+	}
+
 	// remove header
 	rows = rows[1:len(rows):len(rows)]
 	min, err := strconv.ParseInt(rows[0][0], 10, 64)
@@ -204,8 +230,8 @@ func ReadCSV(fpath string) (*CSV, error) {
 		DiskDevice:       "",
 		NetworkInterface: "",
 
-		Header:            ProcHeader,
-		HeaderIndex:       ProcHeaderIndex,
+		Header:            header,
+		HeaderIndex:       headerIndex,
 		MinUnixNanosecond: min,
 		MinUnixSecond:     nanoToUnix(min),
 		MaxUnixNanosecond: max,
@@ -213,154 +239,164 @@ func ReadCSV(fpath string) (*CSV, error) {
 
 		Rows: make([]Proc, 0, len(rows)),
 	}
-	for _, row := range rows {
-		ts, err := strconv.ParseInt(row[ProcHeaderIndex["UNIX-NANOSECOND"]], 10, 64)
+	_, c.Pressure = headerIndex[columnsPressure[0]]
+	for _, r := range rows {
+		row := csvRow{index: headerIndex, row: r}
+		ts, err := row.parseInt("UNIX-NANOSECOND")
+		if err != nil {
+			return nil, err
+		}
+		tss, err := row.parseInt("UNIX-SECOND")
 		if err != nil {
 			return nil, err
 		}
-		tss, err := strconv.ParseInt(row[ProcHeaderIndex["UNIX-SECOND"]], 10, 64)
+		pid, err := row.parseInt("PID")
 		if err != nil {
 			return nil, err
 		}
-		pid, err := strconv.ParseInt(row[ProcHeaderIndex["PID"]], 10, 64)
+		ppid, err := row.parseInt("PPID")
 		if err != nil {
 			return nil, err
 		}
-		ppid, err := strconv.ParseInt(row[ProcHeaderIndex["PPID"]], 10, 64)
+		fd, err := row.parseUint("FD")
 		if err != nil {
 			return nil, err
 		}
-		fd, err := strconv.ParseUint(row[ProcHeaderIndex["FD"]], 10, 64)
+		threads, err := row.parseUint("THREADS")
 		if err != nil {
 			return nil, err
 		}
-		threads, err := strconv.ParseUint(row[ProcHeaderIndex["THREADS"]], 10, 64)
+		volCtxNum, err := row.parseUint("VOLUNTARY-CTXT-SWITCHES")
 		if err != nil {
 			return nil, err
 		}
-		volCtxNum, err := strconv.ParseUint(row[ProcHeaderIndex["VOLUNTARY-CTXT-SWITCHES"]], 10, 64)
+		nonVolCtxNum, err := row.parseUint("NON-VOLUNTARY-CTXT-SWITCHES")
 		if err != nil {
 			return nil, err
 		}
-		nonVolCtxNum, err := strconv.ParseUint(row[ProcHeaderIndex["NON-VOLUNTARY-CTXT-SWITCHES"]], 10, 64)
+		cpuNum, err := row.parseFloat("CPU-NUM")
 		if err != nil {
 			return nil, err
 		}
-		cpuNum, err := strconv.ParseFloat(row[ProcHeaderIndex["CPU-NUM"]], 64)
+		vmRssNum, err := row.parseUint("VMRSS-NUM")
 		if err != nil {
 			return nil, err
 		}
-		vmRssNum, err := strconv.ParseUint(row[ProcHeaderIndex["VMRSS-NUM"]], 10, 64)
+		vmSizeNum, err := row.parseUint("VMSIZE-NUM")
 		if err != nil {
 			return nil, err
 		}
-		vmSizeNum, err := strconv.ParseUint(row[ProcHeaderIndex["VMSIZE-NUM"]], 10, 64)
+		pssNum, err := row.parseUint("PSS-NUM")
+		if err != nil {
+			return nil, err
+		}
+		ussNum, err := row.parseUint("USS-NUM")
 		if err != nil {
 			return nil, err
 		}
 
-		loadAvg1min, err := strconv.ParseFloat(row[ProcHeaderIndex["LOAD-AVERAGE-1-MINUTE"]], 64)
+		loadAvg1min, err := row.parseFloat("LOAD-AVERAGE-1-MINUTE")
 		if err != nil {
 			return nil, err
 		}
-		loadAvg5min, err := strconv.ParseFloat(row[ProcHeaderIndex["LOAD-AVERAGE-5-MINUTE"]], 64)
+		loadAvg5min, err := row.parseFloat("LOAD-AVERAGE-5-MINUTE")
 		if err != nil {
 			return nil, err
 		}
-		loadAvg15min, err := strconv.ParseFloat(row[ProcHeaderIndex["LOAD-AVERAGE-15-MINUTE"]], 64)
+		loadAvg15min, err := row.parseFloat("LOAD-AVERAGE-15-MINUTE")
 		if err != nil {
 			return nil, err
 		}
 
-		readsCompleted, err := strconv.ParseUint(row[ProcHeaderIndex["READS-COMPLETED"]], 10, 64)
+		readsCompleted, err := row.parseUint("READS-COMPLETED")
 		if err != nil {
 			return nil, err
 		}
-		sectorsRead, err := strconv.ParseUint(row[ProcHeaderIndex["SECTORS-READ"]], 10, 64)
+		sectorsRead, err := row.parseUint("SECTORS-READ")
 		if err != nil {
 			return nil, err
 		}
-		writesCompleted, err := strconv.ParseUint(row[ProcHeaderIndex["WRITES-COMPLETED"]], 10, 64)
+		writesCompleted, err := row.parseUint("WRITES-COMPLETED")
 		if err != nil {
 			return nil, err
 		}
-		sectorsWritten, err := strconv.ParseUint(row[ProcHeaderIndex["SECTORS-WRITTEN"]], 10, 64)
+		sectorsWritten, err := row.parseUint("SECTORS-WRITTEN")
 		if err != nil {
 			return nil, err
 		}
-		timeSpentOnReadingMs, err := strconv.ParseUint(row[ProcHeaderIndex["MILLISECONDS(READS)"]], 10, 64)
+		timeSpentOnReadingMs, err := row.parseUint("MILLISECONDS(READS)")
 		if err != nil {
 			return nil, err
 		}
-		timeSpentOnWritingMs, err := strconv.ParseUint(row[ProcHeaderIndex["MILLISECONDS(WRITES)"]], 10, 64)
+		timeSpentOnWritingMs, err := row.parseUint("MILLISECONDS(WRITES)")
 		if err != nil {
 			return nil, err
 		}
 
-		readsCompletedDelta, err := strconv.ParseUint(row[ProcHeaderIndex["READS-COMPLETED-DELTA"]], 10, 64)
+		readsCompletedDelta, err := row.parseUint("READS-COMPLETED-DELTA")
 		if err != nil {
 			return nil, err
 		}
-		sectorsReadDelta, err := strconv.ParseUint(row[ProcHeaderIndex["SECTORS-READ-DELTA"]], 10, 64)
+		sectorsReadDelta, err := row.parseUint("SECTORS-READ-DELTA")
 		if err != nil {
 			return nil, err
 		}
-		writesCompletedDelta, err := strconv.ParseUint(row[ProcHeaderIndex["WRITES-COMPLETED-DELTA"]], 10, 64)
+		writesCompletedDelta, err := row.parseUint("WRITES-COMPLETED-DELTA")
 		if err != nil {
 			return nil, err
 		}
-		sectorsWrittenDelta, err := strconv.ParseUint(row[ProcHeaderIndex["SECTORS-WRITTEN-DELTA"]], 10, 64)
+		sectorsWrittenDelta, err := row.parseUint("SECTORS-WRITTEN-DELTA")
 		if err != nil {
 			return nil, err
 		}
 
-		readBytesDelta, err := strconv.ParseUint(row[ProcHeaderIndex["READ-BYTES-DELTA"]], 10, 64)
+		readBytesDelta, err := row.parseUint("READ-BYTES-DELTA")
 		if err != nil {
 			return nil, err
 		}
-		readMegabytesDelta, err := strconv.ParseUint(row[ProcHeaderIndex["READ-MEGABYTES-DELTA"]], 10, 64)
+		readMegabytesDelta, err := row.parseUint("READ-MEGABYTES-DELTA")
 		if err != nil {
 			return nil, err
 		}
-		writeBytesDelta, err := strconv.ParseUint(row[ProcHeaderIndex["WRITE-BYTES-DELTA"]], 10, 64)
+		writeBytesDelta, err := row.parseUint("WRITE-BYTES-DELTA")
 		if err != nil {
 			return nil, err
 		}
-		writeMegabytesDelta, err := strconv.ParseUint(row[ProcHeaderIndex["WRITE-MEGABYTES-DELTA"]], 10, 64)
+		writeMegabytesDelta, err := row.parseUint("WRITE-MEGABYTES-DELTA")
 		if err != nil {
 			return nil, err
 		}
 
-		receivePackets, err := strconv.ParseUint(row[ProcHeaderIndex["RECEIVE-PACKETS"]], 10, 64)
+		receivePackets, err := row.parseUint("RECEIVE-PACKETS")
 		if err != nil {
 			return nil, err
 		}
-		transmitPackets, err := strconv.ParseUint(row[ProcHeaderIndex["TRANSMIT-PACKETS"]], 10, 64)
+		transmitPackets, err := row.parseUint("TRANSMIT-PACKETS")
 		if err != nil {
 			return nil, err
 		}
-		receiveBytesNum, err := strconv.ParseUint(row[ProcHeaderIndex["RECEIVE-BYTES-NUM"]], 10, 64)
+		receiveBytesNum, err := row.parseUint("RECEIVE-BYTES-NUM")
 		if err != nil {
 			return nil, err
 		}
-		transmitBytesNum, err := strconv.ParseUint(row[ProcHeaderIndex["TRANSMIT-BYTES-NUM"]], 10, 64)
+		transmitBytesNum, err := row.parseUint("TRANSMIT-BYTES-NUM")
 		if err != nil {
 			return nil, err
 		}
 
-		receivePacketsDelta, err := strconv.ParseUint(row[ProcHeaderIndex["RECEIVE-PACKETS-DELTA"]], 10, 64)
+		receivePacketsDelta, err := row.parseUint("RECEIVE-PACKETS-DELTA")
 		if err != nil {
 			return nil, err
 		}
-		transmitPacketsDelta, err := strconv.ParseUint(row[ProcHeaderIndex["TRANSMIT-PACKETS-DELTA"]], 10, 64)
+		transmitPacketsDelta, err := row.parseUint("TRANSMIT-PACKETS-DELTA")
 		if err != nil {
 			return nil, err
 		}
-		receiveBytesNumDelta, err := strconv.ParseUint(row[ProcHeaderIndex["RECEIVE-BYTES-NUM-DELTA"]], 10, 64)
+		receiveBytesNumDelta, err := row.parseUint("RECEIVE-BYTES-NUM-DELTA")
 		if err != nil {
 			return nil, err
 		}
-		transmitBytesNumDelta, err := strconv.ParseUint(row[ProcHeaderIndex["TRANSMIT-BYTES-NUM-DELTA"]], 10, 64)
+		transmitBytesNumDelta, err := row.parseUint("TRANSMIT-BYTES-NUM-DELTA")
 		if err != nil {
 			return nil, err
 		}
@@ -370,13 +406,15 @@ func ReadCSV(fpath string) (*CSV, error) {
 			UnixSecond:     tss,
 
 			PSEntry: PSEntry{
-				Program:                  row[ProcHeaderIndex["PROGRAM"]],
-				State:                    row[ProcHeaderIndex["STATE"]],
+				Program:                  row.get("PROGRAM"),
+				State:                    row.get("STATE"),
 				PID:                      pid,
 				PPID:                     ppid,
-				CPU:                      row[ProcHeaderIndex["CPU"]],
-				VMRSS:                    row[ProcHeaderIndex["VMRSS"]],
-				VMSize:                   row[ProcHeaderIndex["VMSIZE"]],
+				CPU:                      row.get("CPU"),
+				VMRSS:                    row.get("VMRSS"),
+				VMSize:                   row.get("VMSIZE"),
+				PSS:                      row.get("PSS"),
+				USS:                      row.get("USS"),
 				FD:                       fd,
 				Threads:                  threads,
 				VoluntaryCtxtSwitches:    volCtxNum,
@@ -384,6 +422,8 @@ func ReadCSV(fpath string) (*CSV, error) {
 				CPUNum:    cpuNum,
 				VMRSSNum:  vmRssNum,
 				VMSizeNum: vmSizeNum,
+				PSSNum:    pssNum,
+				USSNum:    ussNum,
 			},
 
 			LoadAvg: proc.LoadAvg{
@@ -393,13 +433,13 @@ func ReadCSV(fpath string) (*CSV, error) {
 			},
 
 			DSEntry: DSEntry{
-				Device:               row[ProcHeaderIndex["DEVICE"]],
+				Device:               row.get("DEVICE"),
 				ReadsCompleted:       readsCompleted,
 				SectorsRead:          sectorsRead,
-				TimeSpentOnReading:   row[ProcHeaderIndex["TIME(READS)"]],
+				TimeSpentOnReading:   row.get("TIME(READS)"),
 				WritesCompleted:      writesCompleted,
 				SectorsWritten:       sectorsWritten,
-				TimeSpentOnWriting:   row[ProcHeaderIndex["TIME(WRITES)"]],
+				TimeSpentOnWriting:   row.get("TIME(WRITES)"),
 				TimeSpentOnReadingMs: timeSpentOnReadingMs,
 				TimeSpentOnWritingMs: timeSpentOnWritingMs,
 			},
@@ -414,22 +454,27 @@ func ReadCSV(fpath string) (*CSV, error) {
 			WriteMegabytesDelta: writeMegabytesDelta,
 
 			NSEntry: NSEntry{
-				Interface:        row[ProcHeaderIndex["INTERFACE"]],
-				ReceiveBytes:     row[ProcHeaderIndex["RECEIVE-BYTES"]],
+				Interface:        row.get("INTERFACE"),
+				ReceiveBytes:     row.get("RECEIVE-BYTES"),
 				ReceivePackets:   receivePackets,
-				TransmitBytes:    row[ProcHeaderIndex["TRANSMIT-BYTES"]],
+				TransmitBytes:    row.get("TRANSMIT-BYTES"),
 				TransmitPackets:  transmitPackets,
 				ReceiveBytesNum:  receiveBytesNum,
 				TransmitBytesNum: transmitBytesNum,
 			},
-			ReceiveBytesDelta:     row[ProcHeaderIndex["RECEIVE-BYTES-DELTA"]],
+			ReceiveBytesDelta:     row.get("RECEIVE-BYTES-DELTA"),
 			ReceivePacketsDelta:   receivePacketsDelta,
-			TransmitBytesDelta:    row[ProcHeaderIndex["TRANSMIT-BYTES-DELTA"]],
+			TransmitBytesDelta:    row.get("TRANSMIT-BYTES-DELTA"),
 			TransmitPacketsDelta:  transmitPacketsDelta,
 			ReceiveBytesNumDelta:  receiveBytesNumDelta,
 			TransmitBytesNumDelta: transmitBytesNumDelta,
 
-			Extra: []byte(row[ProcHeaderIndex["EXTRA"]]),
+			Extra: []byte(row.get("EXTRA")),
+		}
+		for i, v := range pc.pressureFields() {
+			if *v, err = row.parseFloat(columnsPressure[i]); err != nil {
+				return nil, err
+			}
 		}
 		c.PID = pc.PSEntry.PID
 		c.DiskDevice = pc.DSEntry.Device
@@ -440,3 +485,42 @@ func ReadCSV(fpath string) (*CSV, error) {
 
 	return c, nil
 }
+
+// csvRow reads the columns of a row by the header of the file.
+type csvRow struct {
+	index map[string]int
+	row   []string
+}
+
+// get returns the column, or empty if not in the row.
+func (r csvRow) get(column string) string {
+	i, ok := r.index[column]
+	if !ok || i >= len(r.row) {
+		return ""
+	}
+	return r.row[i]
+}
+
+func (r csvRow) parseInt(column string) (int64, error) {
+	v := r.get(column)
+	if v == "" {
+		return 0, nil
+	}
+	return strconv.ParseInt(v, 10, 64)
+}
+
+func (r csvRow) parseUint(column string) (uint64, error) {
+	v := r.get(column)
+	if v == "" {
+		return 0, nil
+	}
+	return strconv.ParseUint(v, 10, 64)
+}
+
+func (r csvRow) parseFloat(column string) (float64, error) {
+	v := r.get(column)
+	if v == "" {
+		return 0, nil
+	}
+	return strconv.ParseFloat(v, 64)
+}
@@ -34,6 +34,8 @@ func Combine(procs ...Proc) Proc {
 		cpuNum                   float64
 		vmRSSNum                 uint64
 		vmSizeNum                uint64
+		pssNum                   uint64
+		ussNum                   uint64
 
 		// for LoadAvg
 		loadAvg1Minute                   float64
@@ -71,6 +73,9 @@ func Combine(procs ...Proc) Proc {
 		transmitPacketsDelta  uint64
 		receiveBytesNumDelta  uint64
 		transmitBytesNumDelta uint64
+
+		// for Pressure
+		pressure = make([]float64, len(columnsPressure))
 	)
 
 	for _, p := range procs {
@@ -80,6 +85,8 @@ func Combine(procs ...Proc) Proc {
 		cpuNum += p.PSEntry.CPUNum
 		vmRSSNum += p.PSEntry.VMRSSNum
 		vmSizeNum += p.PSEntry.VMSizeNum
+		pssNum += p.PSEntry.PSSNum
+		ussNum += p.PSEntry.USSNum
 
 		// for LoadAvg
 		loadAvg1Minute += p.LoadAvg.LoadAvg1Minute
@@ -117,6 +124,11 @@ func Combine(procs ...Proc) Proc {
 		transmitPacketsDelta += p.TransmitPacketsDelta
 		receiveBytesNumDelta += p.ReceiveBytesNumDelta
 		transmitBytesNumDelta += p.TransmitBytesNumDelta
+
+		// for Pressure
+		for i, v := range p.pressureFields() {
+			pressure[i] += *v
+		}
 	}
 
 	pN := len(procs)
@@ -130,6 +142,10 @@ func Combine(procs ...Proc) Proc {
 	combined.PSEntry.VMRSS = humanize.Bytes(combined.PSEntry.VMRSSNum)
 	combined.PSEntry.VMSizeNum = uint64(vmSizeNum) / uint64(pN)
 	combined.PSEntry.VMSize = humanize.Bytes(combined.PSEntry.VMSizeNum)
+	combined.PSEntry.PSSNum = uint64(pssNum) / uint64(pN)
+	combined.PSEntry.PSS = humanize.Bytes(combined.PSEntry.PSSNum)
+	combined.PSEntry.USSNum = uint64(ussNum) / uint64(pN)
+	combined.PSEntry.USS = humanize.Bytes(combined.PSEntry.USSNum)
 
 	// for LoadAvg
 	combined.LoadAvg.LoadAvg1Minute = float64(loadAvg1Minute) / float64(pN)
@@ -170,6 +186,11 @@ func Combine(procs ...Proc) Proc {
 	combined.TransmitBytesNumDelta = uint64(transmitBytesNumDelta) / uint64(pN)
 	combined.TransmitBytesDelta = humanize.Bytes(combined.TransmitBytesNumDelta)
 
+	// for Pressure
+	for i, v := range combined.pressureFields() {
+		*v = pressure[i] / float64(pN)
+	}
+
 	return combined
 }
 
@@ -199,6 +220,8 @@ func Interpolate(lower, upper Proc) (procs []Proc, err error) {
 		cpuNum                   = (upper.PSEntry.CPUNum - lower.PSEntry.CPUNum) / float64(expectedRowN-1)
 		vmRSSNum                 = int64(upper.PSEntry.VMRSSNum-lower.PSEntry.VMRSSNum) / (expectedRowN - 1)
 		vmSizeNum                = int64(upper.PSEntry.VMSizeNum-lower.PSEntry.VMSizeNum) / (expectedRowN - 1)
+		pssNum                   = int64(upper.PSEntry.PSSNum-lower.PSEntry.PSSNum) / (expectedRowN - 1)
+		ussNum                   = int64(upper.PSEntry.USSNum-lower.PSEntry.USSNum) / (expectedRowN - 1)
 
 		// for LoadAvg
 		loadAvg1Minute                   = (upper.LoadAvg.LoadAvg1Minute - lower.LoadAvg.LoadAvg1Minute) / float64(expectedRowN-1)
@@ -236,7 +259,14 @@ func Interpolate(lower, upper Proc) (procs []Proc, err error) {
 		transmitPacketsDelta  = int64(upper.TransmitPacketsDelta-lower.TransmitPacketsDelta) / (expectedRowN - 1)
 		receiveBytesNumDelta  = int64(upper.ReceiveBytesNumDelta-lower.ReceiveBytesNumDelta) / (expectedRowN - 1)
 		transmitBytesNumDelta = int64(upper.TransmitBytesNumDelta-lower.TransmitBytesNumDelta) / (expectedRowN - 1)
+
+		// for Pressure
+		pressure = make([]float64, len(columnsPressure))
 	)
+	lowerPressure, upperPressure := lower.pressureFields(), upper.pressureFields()
+	for i := range pressure {
+		pressure[i] = (*upperPressure[i] - *lowerPressure[i]) / float64(expectedRowN-1)
+	}
 
 	procs = make([]Proc, expectedRowN-2)
 	for i := range procs {
@@ -254,6 +284,10 @@ func Interpolate(lower, upper Proc) (procs []Proc, err error) {
 		procs[i].PSEntry.VMRSS = humanize.Bytes(procs[i].PSEntry.VMRSSNum)
 		procs[i].PSEntry.VMSizeNum = uint64(int64(lower.PSEntry.VMSizeNum) + int64(i+1)*vmSizeNum)
 		procs[i].PSEntry.VMSize = humanize.Bytes(procs[i].PSEntry.VMSizeNum)
+		procs[i].PSEntry.PSSNum = uint64(int64(lower.PSEntry.PSSNum) + int64(i+1)*pssNum)
+		procs[i].PSEntry.PSS = humanize.Bytes(procs[i].PSEntry.PSSNum)
+		procs[i].PSEntry.USSNum = uint64(int64(lower.PSEntry.USSNum) + int64(i+1)*ussNum)
+		procs[i].PSEntry.USS = humanize.Bytes(procs[i].PSEntry.USSNum)
 
 		// for LoadAvg
 		procs[i].LoadAvg.LoadAvg1Minute = lower.LoadAvg.LoadAvg1Minute + float64(i+1)*loadAvg1Minute
@@ -293,6 +327,11 @@ func Interpolate(lower, upper Proc) (procs []Proc, err error) {
 		procs[i].ReceiveBytesDelta = humanize.Bytes(procs[i].ReceiveBytesNumDelta)
 		procs[i].TransmitBytesNumDelta = uint64(int64(lower.TransmitBytesNumDelta) + int64(i+1)*transmitBytesNumDelta)
 		procs[i].TransmitBytesDelta = humanize.Bytes(procs[i].TransmitBytesNumDelta)
+
+		// for Pressure
+		for j, v := range procs[i].pressureFields() {
+			*v = *lowerPressure[j] + float64(i+1)*pressure[j]
+		}
 	}
 
 	return
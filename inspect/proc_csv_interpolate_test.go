@@ -173,7 +173,7 @@ func TestInterpolateMissingOne(t *testing.T) {
 			{
 				UnixNanosecond: 0,
 				UnixSecond:     2,
-				PSEntry:        PSEntry{CPU: "0.00 %", VMRSS: "0 B", VMSize: "0 B"},
+				PSEntry:        PSEntry{CPU: "0.00 %", VMRSS: "0 B", VMSize: "0 B", PSS: "0 B", USS: "0 B"},
 				LoadAvg: proc.LoadAvg{
 					LoadAvg1Minute:  25.0,
 					LoadAvg15Minute: 75.0,
@@ -309,7 +309,7 @@ func TestInterpolateMissingALot(t *testing.T) {
 			{
 				UnixNanosecond: 0,
 				UnixSecond:     2,
-				PSEntry:        PSEntry{CPU: "0.00 %", VMRSS: "0 B", VMSize: "0 B"},
+				PSEntry:        PSEntry{CPU: "0.00 %", VMRSS: "0 B", VMSize: "0 B", PSS: "0 B", USS: "0 B"},
 				LoadAvg: proc.LoadAvg{
 					LoadAvg1Minute:  10,
 					LoadAvg15Minute: 30,
@@ -328,7 +328,7 @@ func TestInterpolateMissingALot(t *testing.T) {
 			{
 				UnixNanosecond: 0,
 				UnixSecond:     3,
-				PSEntry:        PSEntry{CPU: "0.00 %", VMRSS: "0 B", VMSize: "0 B"},
+				PSEntry:        PSEntry{CPU: "0.00 %", VMRSS: "0 B", VMSize: "0 B", PSS: "0 B", USS: "0 B"},
 				LoadAvg: proc.LoadAvg{
 					LoadAvg1Minute:  15,
 					LoadAvg15Minute: 45,
@@ -347,7 +347,7 @@ func TestInterpolateMissingALot(t *testing.T) {
 			{
 				UnixNanosecond: 0,
 				UnixSecond:     4,
-				PSEntry:        PSEntry{CPU: "0.00 %", VMRSS: "0 B", VMSize: "0 B"},
+				PSEntry:        PSEntry{CPU: "0.00 %", VMRSS: "0 B", VMSize: "0 B", PSS: "0 B", USS: "0 B"},
 				LoadAvg: proc.LoadAvg{
 					LoadAvg1Minute:  20.0,
 					LoadAvg15Minute: 60.0,
@@ -366,7 +366,7 @@ func TestInterpolateMissingALot(t *testing.T) {
 			{
 				UnixNanosecond: 0,
 				UnixSecond:     5,
-				PSEntry:        PSEntry{CPU: "0.00 %", VMRSS: "0 B", VMSize: "0 B"},
+				PSEntry:        PSEntry{CPU: "0.00 %", VMRSS: "0 B", VMSize: "0 B", PSS: "0 B", USS: "0 B"},
 				LoadAvg: proc.LoadAvg{
 					LoadAvg1Minute:  25,
 					LoadAvg15Minute: 75,
@@ -385,7 +385,7 @@ func TestInterpolateMissingALot(t *testing.T) {
 			{
 				UnixNanosecond: 0,
 				UnixSecond:     6,
-				PSEntry:        PSEntry{CPU: "0.00 %", VMRSS: "0 B", VMSize: "0 B"},
+				PSEntry:        PSEntry{CPU: "0.00 %", VMRSS: "0 B", VMSize: "0 B", PSS: "0 B", USS: "0 B"},
 				LoadAvg: proc.LoadAvg{
 					LoadAvg1Minute:  30.0,
 					LoadAvg15Minute: 90.0,
@@ -404,7 +404,7 @@ func TestInterpolateMissingALot(t *testing.T) {
 			{
 				UnixNanosecond: 0,
 				UnixSecond:     7,
-				PSEntry:        PSEntry{CPU: "0.00 %", VMRSS: "0 B", VMSize: "0 B"},
+				PSEntry:        PSEntry{CPU: "0.00 %", VMRSS: "0 B", VMSize: "0 B", PSS: "0 B", USS: "0 B"},
 				LoadAvg: proc.LoadAvg{
 					LoadAvg1Minute:  35,
 					LoadAvg15Minute: 105,
@@ -423,7 +423,7 @@ func TestInterpolateMissingALot(t *testing.T) {
 			{
 				UnixNanosecond: 0,
 				UnixSecond:     8,
-				PSEntry:        PSEntry{CPU: "0.00 %", VMRSS: "0 B", VMSize: "0 B"},
+				PSEntry:        PSEntry{CPU: "0.00 %", VMRSS: "0 B", VMSize: "0 B", PSS: "0 B", USS: "0 B"},
 				LoadAvg: proc.LoadAvg{
 					LoadAvg1Minute:  40.0,
 					LoadAvg15Minute: 120.0,
@@ -442,7 +442,7 @@ func TestInterpolateMissingALot(t *testing.T) {
 			{
 				UnixNanosecond: 0,
 				UnixSecond:     9,
-				PSEntry:        PSEntry{CPU: "0.00 %", VMRSS: "0 B", VMSize: "0 B"},
+				PSEntry:        PSEntry{CPU: "0.00 %", VMRSS: "0 B", VMSize: "0 B", PSS: "0 B", USS: "0 B"},
 				LoadAvg: proc.LoadAvg{
 					LoadAvg1Minute:  45,
 					LoadAvg15Minute: 135,
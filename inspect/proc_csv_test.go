@@ -271,3 +271,84 @@ func homeDir() string {
 	}
 	return os.Getenv("HOME")
 }
+
+func TestProcCSVPressure(t *testing.T) {
+	fpath := filepath.Join(os.TempDir(), fmt.Sprintf("test-%010d.csv", time.Now().UnixNano()))
+	defer os.RemoveAll(fpath)
+
+	p1 := Proc{UnixNanosecond: 1e9, UnixSecond: 1}
+	p1.Pressure.CPU.Some.Avg10 = 1.5
+	p1.Pressure.Memory.Full.Avg60 = 2.25
+	p2 := Proc{UnixNanosecond: 3e9, UnixSecond: 3}
+	p2.Pressure.CPU.Some.Avg10 = 3.5
+	p2.Pressure.IO.Full.Avg300 = 4.75
+
+	c := &CSV{FilePath: fpath, Pressure: true, Rows: []Proc{p1, p2}}
+	if err := c.Save(); err != nil {
+		t.Fatal(err)
+	}
+	cv, err := ReadCSV(fpath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cv.Pressure || len(cv.Header) != len(ProcHeaderWithPressure) {
+		t.Fatalf("expected PSI columns, got %v", cv.Header)
+	}
+	if len(cv.Rows) != 2 || cv.Rows[0].Pressure != p1.Pressure || cv.Rows[1].Pressure != p2.Pressure {
+		t.Fatalf("unexpected pressure %+v", cv.Rows)
+	}
+
+	combined := Combine(p1, p2)
+	if combined.Pressure.CPU.Some.Avg10 != 2.5 || combined.Pressure.Memory.Full.Avg60 != 1.125 {
+		t.Fatalf("unexpected combined pressure %+v", combined.Pressure)
+	}
+
+	procs, err := Interpolate(p1, p2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(procs) != 1 || procs[0].Pressure.CPU.Some.Avg10 != 2.5 || procs[0].Pressure.IO.Full.Avg300 != 2.375 {
+		t.Fatalf("unexpected interpolated pressure %+v", procs)
+	}
+}
+
+func TestReadCSVOlderColumns(t *testing.T) {
+	fpath := filepath.Join(os.TempDir(), fmt.Sprintf("test-%010d.csv", time.Now().UnixNano()))
+	defer os.RemoveAll(fpath)
+
+	// written before 'PSS', 'USS', 'CONTAINER' and PSI columns,
+	// and with a short row
+	txt := `UNIX-NANOSECOND,UNIX-SECOND,PROGRAM,PID,VMRSS-NUM,EXTRA
+1000000000,1,bash,42,2048,abc
+2000000000,2,bash
+`
+	if err := ioutil.WriteFile(fpath, []byte(txt), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cv, err := ReadCSV(fpath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cv.Pressure || len(cv.Header) != 6 || len(cv.Rows) != 2 {
+		t.Fatalf("unexpected %+v", cv)
+	}
+	r := cv.Rows[0]
+	if r.PSEntry.Program != "bash" || r.PSEntry.PID != 42 || r.PSEntry.VMRSSNum != 2048 || r.PSEntry.PSSNum != 0 || string(r.Extra) != "abc" {
+		t.Fatalf("unexpected row %+v", r)
+	}
+	if r = cv.Rows[1]; r.UnixSecond != 2 || r.PSEntry.PID != 0 || len(r.Extra) != 0 {
+		t.Fatalf("unexpected row %+v", r)
+	}
+}
+
+func TestProcToRow(t *testing.T) {
+	p := Proc{Extra: []byte("abc")}
+	p.Pressure.IO.Full.Avg300 = 4.75
+	if row := p.ToRow(); len(row) != len(ProcHeader) || row[ProcHeaderIndex["EXTRA"]] != "abc" {
+		t.Fatalf("unexpected row %v", row)
+	}
+	row := p.ToRowWithPressure()
+	if len(row) != len(ProcHeaderWithPressure) || row[ProcHeaderWithPressureIndex["EXTRA"]] != "abc" || row[ProcHeaderWithPressureIndex["PSI-IO-FULL-AVG300"]] != "4.75" {
+		t.Fatalf("unexpected row %v", row)
+	}
+}
@@ -4,11 +4,13 @@ import (
 	"bytes"
 	"fmt"
 	"log"
+	"sort"
 	"sync"
 
 	"github.com/gyuho/linux-inspect/proc"
 	"github.com/gyuho/linux-inspect/top"
 
+	humanize "github.com/dustin/go-humanize"
 	"github.com/gyuho/dataframe"
 	"github.com/olekukonko/tablewriter"
 )
@@ -21,10 +23,29 @@ type PSEntry struct {
 	PID     int64
 	PPID    int64
 
+	// Args is the full command line from '/proc/$PID/cmdline',
+	// while 'Program' is truncated to 15 characters.
+	Args []string
+	// Exe is the executable path from '/proc/$PID/exe',
+	// empty if not readable.
+	Exe string
+
+	// Cgroup is the cgroup path from '/proc/$PID/cgroup', preferring
+	// the cgroup v2 unified hierarchy. Container is resolved from it.
+	Cgroup    string
+	Container Container
+
 	CPU    string
 	VMRSS  string
 	VMSize string
 
+	// PSS is the proportional set size, where each shared page is
+	// divided by the number of processes sharing it, and USS is the
+	// unique set size that is only used by the process. Both are from
+	// '/proc/$PID/smaps_rollup', and empty if not readable.
+	PSS string
+	USS string
+
 	FD      uint64
 	Threads uint64
 
@@ -35,6 +56,43 @@ type PSEntry struct {
 	CPUNum    float64
 	VMRSSNum  uint64
 	VMSizeNum uint64
+	PSSNum    uint64
+	USSNum    uint64
+}
+
+// PS sort keys for 'WithSortBy' and 'SortPS', in descending order.
+const (
+	PSSortByCPU    = "cpu"
+	PSSortByVMRSS  = "vmrss"
+	PSSortByVMSize = "vmsize"
+	PSSortByPSS    = "pss"
+	PSSortByUSS    = "uss"
+)
+
+// psSortColumns maps each sort key to the column in 'ConvertPS'.
+var psSortColumns = map[string]int{
+	PSSortByCPU:    14,
+	PSSortByVMRSS:  15,
+	PSSortByVMSize: 16,
+	PSSortByPSS:    17,
+	PSSortByUSS:    18,
+}
+
+func psSortValue(p PSEntry, key string) float64 {
+	switch key {
+	case PSSortByCPU:
+		return p.CPUNum
+	case PSSortByVMRSS:
+		return float64(p.VMRSSNum)
+	case PSSortByVMSize:
+		return float64(p.VMSizeNum)
+	case PSSortByPSS:
+		return float64(p.PSSNum)
+	case PSSortByUSS:
+		return float64(p.USSNum)
+	default:
+		return 0
+	}
 }
 
 const maxConcurrentProcFDLimit = 32
@@ -43,6 +101,9 @@ const maxConcurrentProcFDLimit = 32
 func GetPS(opts ...OpFunc) (pss []PSEntry, err error) {
 	op := &EntryOp{}
 	op.applyOpts(opts)
+	if _, ok := psSortColumns[op.SortBy]; op.SortBy != "" && !ok {
+		return nil, fmt.Errorf("unknown sort key %q", op.SortBy)
+	}
 
 	var pids []int64
 	switch {
@@ -73,9 +134,14 @@ func GetPS(opts ...OpFunc) (pss []PSEntry, err error) {
 	} else {
 		op.ProgramMatchFunc = func(string) bool { return true }
 	}
+	readSmaps := op.Smaps || op.SortBy == PSSortByPSS || op.SortBy == PSSortByUSS
+
+	var cpuM map[int64]float64
+	switch {
+	case op.TopStream != nil:
+		cpuM = cpuFromTop(op.TopStream.Latest())
 
-	var topM map[int64]top.Row
-	if op.TopStream == nil {
+	case op.TopExecPath != "":
 		var topRows []top.Row
 		if len(pids) == 1 {
 			topRows, err = top.Get(op.TopExecPath, pids[0])
@@ -88,18 +154,26 @@ func GetPS(opts ...OpFunc) (pss []PSEntry, err error) {
 				return
 			}
 		}
-		topM = make(map[int64]top.Row, len(topRows))
+		topM := make(map[int64]top.Row, len(topRows))
 		for _, row := range topRows {
 			topM[row.PID] = row
 		}
 		for _, pid := range pids {
 			if _, ok := topM[pid]; !ok {
-				topM[pid] = top.Row{PID: pid}
 				log.Printf("PID %d is not found at 'top' command output", pid)
 			}
 		}
-	} else {
-		topM = op.TopStream.Latest()
+		cpuM = cpuFromTop(topM)
+
+	default:
+		sampler := op.CPUSampler
+		if sampler == nil {
+			sampler = NewCPUSampler(DefaultCPUSampleInterval)
+		}
+		cpuM, err = sampler.Sample(pids)
+		if err != nil {
+			return
+		}
 	}
 
 	var pmu sync.RWMutex
@@ -115,23 +189,28 @@ func GetPS(opts ...OpFunc) (pss []PSEntry, err error) {
 
 			limitc <- struct{}{}
 
-			topRow := topM[pid]
-			if !op.ProgramMatchFunc(topRow.COMMAND) {
-				return
-			}
-
+			// need all entries to sort before limit
 			pmu.RLock()
-			done := op.TopLimit > 0 && len(pss) >= op.TopLimit
+			done := op.SortBy == "" && op.TopLimit > 0 && len(pss) >= op.TopLimit
 			pmu.RUnlock()
 			if done {
 				return
 			}
 
-			ent, err := getPSEntry(pid, topRow)
+			ent, err := getPSEntry(pid, cpuM[pid], readSmaps)
 			if err != nil {
 				log.Printf("getPSEntry error %v for PID %d", err, pid)
 				return
 			}
+			if !op.ProgramMatchFunc(ent.Program) {
+				return
+			}
+			if !op.matchProcess(ent.Args, ent.Exe) {
+				return
+			}
+			if !op.matchCgroup(ent.Cgroup, ent.Container) {
+				return
+			}
 
 			pmu.Lock()
 			pss = append(pss, ent)
@@ -140,13 +219,28 @@ func GetPS(opts ...OpFunc) (pss []PSEntry, err error) {
 	}
 	wg.Wait()
 
+	if op.SortBy != "" {
+		sort.SliceStable(pss, func(i, j int) bool {
+			return psSortValue(pss[i], op.SortBy) > psSortValue(pss[j], op.SortBy)
+		})
+	}
 	if op.TopLimit > 0 && len(pss) > op.TopLimit {
 		pss = pss[:op.TopLimit:op.TopLimit]
 	}
 	return
 }
 
-func getPSEntry(pid int64, topRow top.Row) (PSEntry, error) {
+// cpuFromTop maps each PID to '%CPU' in 'top' command output.
+func cpuFromTop(topM map[int64]top.Row) map[int64]float64 {
+	cpuM := make(map[int64]float64, len(topM))
+	for pid, row := range topM {
+		cpuM[pid] = row.CPUPercent
+	}
+	return cpuM
+}
+
+// getPSEntry reads PSS and USS only if readSmaps is true.
+func getPSEntry(pid int64, cpuPercent float64, readSmaps bool) (PSEntry, error) {
 	status, err := proc.GetStatusByPID(pid)
 	if err != nil {
 		return PSEntry{}, err
@@ -159,7 +253,7 @@ func getPSEntry(pid int64, topRow top.Row) (PSEntry, error) {
 		PID:  status.Pid,
 		PPID: status.PPid,
 
-		CPU:    fmt.Sprintf("%3.2f %%", topRow.CPUPercent),
+		CPU:    fmt.Sprintf("%3.2f %%", cpuPercent),
 		VMRSS:  status.VmRSSParsedBytes,
 		VMSize: status.VmSizeParsedBytes,
 
@@ -169,11 +263,27 @@ func getPSEntry(pid int64, topRow top.Row) (PSEntry, error) {
 		VoluntaryCtxtSwitches:    status.VoluntaryCtxtSwitches,
 		NonvoluntaryCtxtSwitches: status.NonvoluntaryCtxtSwitches,
 
-		CPUNum:    topRow.CPUPercent,
+		CPUNum:    cpuPercent,
 		VMRSSNum:  status.VmRSSBytesN,
 		VMSizeNum: status.VmSizeBytesN,
 	}
 
+	// may fail for other users' processes without root permission
+	entry.Args, _ = proc.GetCmdline(pid)
+	entry.Exe, _ = proc.GetExe(pid)
+	if cgs, err := proc.GetCgroup(pid); err == nil {
+		entry.Cgroup = cgroupPath(cgs)
+		entry.Container = ResolveContainer(entry.Cgroup)
+	}
+	if readSmaps {
+		if sm, err := proc.GetSmapsRollup(pid); err == nil {
+			entry.PSS = sm.PssParsedBytes
+			entry.USS = humanize.Bytes(sm.USSBytesN())
+			entry.PSSNum = sm.PssBytesN
+			entry.USSNum = sm.USSBytesN()
+		}
+	}
+
 	if status.Name != "" {
 		entry.Program = status.Name
 	}
@@ -184,7 +294,7 @@ func getPSEntry(pid int64, topRow top.Row) (PSEntry, error) {
 	return entry, nil
 }
 
-const columnsPSToShow = 11
+const columnsPSToShow = 14
 
 var columnsPSEntry = []string{
 	"PROGRAM",
@@ -196,6 +306,8 @@ var columnsPSEntry = []string{
 	"CPU",
 	"VMRSS",
 	"VMSIZE",
+	"PSS",
+	"USS",
 
 	"FD",
 	"THREADS",
@@ -203,10 +315,14 @@ var columnsPSEntry = []string{
 	"VOLUNTARY-CTXT-SWITCHES",
 	"NON-VOLUNTARY-CTXT-SWITCHES",
 
+	"CONTAINER",
+
 	// extra for sorting
 	"CPU-NUM",
 	"VMRSS-NUM",
 	"VMSIZE-NUM",
+	"PSS-NUM",
+	"USS-NUM",
 }
 
 // ConvertPS converts to rows.
@@ -224,29 +340,68 @@ func ConvertPS(nss ...PSEntry) (header []string, rows [][]string) {
 		row[4] = elem.CPU
 		row[5] = elem.VMRSS
 		row[6] = elem.VMSize
+		row[7] = elem.PSS
+		row[8] = elem.USS
 
-		row[7] = fmt.Sprintf("%d", elem.FD)
-		row[8] = fmt.Sprintf("%d", elem.Threads)
+		row[9] = fmt.Sprintf("%d", elem.FD)
+		row[10] = fmt.Sprintf("%d", elem.Threads)
 
-		row[9] = fmt.Sprintf("%d", elem.VoluntaryCtxtSwitches)
-		row[10] = fmt.Sprintf("%d", elem.NonvoluntaryCtxtSwitches)
+		row[11] = fmt.Sprintf("%d", elem.VoluntaryCtxtSwitches)
+		row[12] = fmt.Sprintf("%d", elem.NonvoluntaryCtxtSwitches)
 
-		row[11] = fmt.Sprintf("%3.2f", elem.CPUNum)
-		row[12] = fmt.Sprintf("%d", elem.VMRSSNum)
-		row[13] = fmt.Sprintf("%d", elem.VMSizeNum)
+		row[13] = elem.containerName()
+
+		row[14] = fmt.Sprintf("%3.2f", elem.CPUNum)
+		row[15] = fmt.Sprintf("%d", elem.VMRSSNum)
+		row[16] = fmt.Sprintf("%d", elem.VMSizeNum)
+		row[17] = fmt.Sprintf("%d", elem.PSSNum)
+		row[18] = fmt.Sprintf("%d", elem.USSNum)
 
 		rows[i] = row
 	}
 	dataframe.SortBy(
 		rows,
-		dataframe.Float64DescendingFunc(12), // VMRSSNum
-		dataframe.Float64DescendingFunc(11), // CPUNum
-		dataframe.Float64DescendingFunc(13), // VMSizeNum
+		dataframe.Float64DescendingFunc(15), // VMRSSNum
+		dataframe.Float64DescendingFunc(14), // CPUNum
+		dataframe.Float64DescendingFunc(16), // VMSizeNum
 	).Sort(rows)
 
 	return
 }
 
+// SortPS sorts the rows from 'ConvertPS' by the key
+// (e.g. 'PSSortByPSS') in descending order.
+func SortPS(rows [][]string, key string) error {
+	idx, ok := psSortColumns[key]
+	if !ok {
+		return fmt.Errorf("unknown sort key %q", key)
+	}
+	dataframe.SortBy(
+		rows,
+		dataframe.Float64DescendingFunc(idx),
+		dataframe.Float64DescendingFunc(15), // VMRSSNum
+	).Sort(rows)
+	return nil
+}
+
+// GroupPSByContainer groups the rows from 'ConvertPS' by the
+// 'CONTAINER' column, keeping the order within each container.
+func GroupPSByContainer(rows [][]string) {
+	sort.SliceStable(rows, func(i, j int) bool { return rows[i][13] < rows[j][13] })
+}
+
+// containerName returns the short container ID, the pod UID
+// if only the pod is known, or the cgroup path otherwise.
+func (p PSEntry) containerName() string {
+	switch {
+	case p.Container.ID != "":
+		return p.Container.ID[:12]
+	case p.Container.PodUID != "":
+		return "pod" + p.Container.PodUID
+	}
+	return p.Cgroup
+}
+
 // StringPS converts in print-friendly format.
 func StringPS(header []string, rows [][]string, topLimit int) string {
 	buf := new(bytes.Buffer)
@@ -3,6 +3,8 @@ package inspect
 import (
 	"fmt"
 	"os"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/gyuho/linux-inspect/top"
@@ -68,3 +70,61 @@ func TestGetPSWithTopStream(t *testing.T) {
 	}
 	fmt.Println("total", len(rm), "processes")
 }
+
+func TestGetPSSortBy(t *testing.T) {
+	pss, err := GetPS(WithSortBy(PSSortByPSS), WithTopLimit(3))
+	if err != nil {
+		t.Skip(err)
+	}
+	for i := 1; i < len(pss); i++ {
+		if pss[i-1].PSSNum < pss[i].PSSNum {
+			t.Fatalf("PSS expected descending, got %d < %d", pss[i-1].PSSNum, pss[i].PSSNum)
+		}
+	}
+
+	hd, rows := ConvertPS(pss...)
+	if err = SortPS(rows, PSSortByUSS); err != nil {
+		t.Fatal(err)
+	}
+	fmt.Println(StringPS(hd, rows, -1))
+
+	if _, err = GetPS(WithSortBy("unknown")); err == nil {
+		t.Fatal("expected error for unknown sort key")
+	}
+	if err = SortPS(rows, "unknown"); err == nil {
+		t.Fatal("expected error for unknown sort key")
+	}
+}
+
+func TestGetPSWithCmdlineMatch(t *testing.T) {
+	pid := int64(os.Getpid())
+	exe, err := os.Executable()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pss, err := GetPS(WithCmdlineMatch(regexp.MustCompile(regexp.QuoteMeta(strings.Join(os.Args, " ")))), WithExePath(exe))
+	if err != nil {
+		t.Skip(err)
+	}
+	found := false
+	for _, p := range pss {
+		if p.PID == pid {
+			found = true
+			if p.Exe != exe || len(p.Args) != len(os.Args) {
+				t.Fatalf("unexpected %+v", p)
+			}
+		}
+	}
+	if !found {
+		t.Fatalf("PID %d was not found in %+v", pid, pss)
+	}
+
+	pss, err = GetPS(WithPID(pid), WithExePath("/not/exist"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pss) != 0 {
+		t.Fatalf("expected no entry, got %+v", pss)
+	}
+}
@@ -0,0 +1,130 @@
+package inspect
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/gyuho/linux-inspect/proc"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+// PSIEntry is the pressure stall information of a resource.
+// Simplied from 'Pressure' in '/proc/pressure/$RESOURCE'.
+type PSIEntry struct {
+	// Resource is 'cpu', 'memory' or 'io'.
+	Resource string
+
+	SomeAvg10  float64
+	SomeAvg60  float64
+	SomeAvg300 float64
+	SomeTotal  string
+
+	FullAvg10  float64
+	FullAvg60  float64
+	FullAvg300 float64
+	FullTotal  string
+
+	// extra fields for sorting
+	SomeTotalNum uint64
+	FullTotalNum uint64
+}
+
+// GetPSI reads '/proc/pressure' and returns the entries of
+// 'cpu', 'memory' and 'io' in order.
+func GetPSI() ([]PSIEntry, error) {
+	sp, err := proc.GetPressure()
+	if err != nil {
+		return nil, err
+	}
+	return []PSIEntry{
+		newPSIEntry("cpu", sp.CPU),
+		newPSIEntry("memory", sp.Memory),
+		newPSIEntry("io", sp.IO),
+	}, nil
+}
+
+func newPSIEntry(resource string, p proc.Pressure) PSIEntry {
+	return PSIEntry{
+		Resource: resource,
+
+		SomeAvg10:  p.Some.Avg10,
+		SomeAvg60:  p.Some.Avg60,
+		SomeAvg300: p.Some.Avg300,
+		SomeTotal:  p.Some.TotalParsedTime,
+
+		FullAvg10:  p.Full.Avg10,
+		FullAvg60:  p.Full.Avg60,
+		FullAvg300: p.Full.Avg300,
+		FullTotal:  p.Full.TotalParsedTime,
+
+		SomeTotalNum: p.Some.Total,
+		FullTotalNum: p.Full.Total,
+	}
+}
+
+const columnsPSIToShow = 9
+
+var columnsPSIEntry = []string{
+	"RESOURCE",
+
+	"SOME-AVG10",
+	"SOME-AVG60",
+	"SOME-AVG300",
+	"SOME-TOTAL",
+
+	"FULL-AVG10",
+	"FULL-AVG60",
+	"FULL-AVG300",
+	"FULL-TOTAL",
+
+	// extra for sorting
+	"SOME-TOTAL-NUM",
+	"FULL-TOTAL-NUM",
+}
+
+// ConvertPSI converts to rows.
+func ConvertPSI(es ...PSIEntry) (header []string, rows [][]string) {
+	header = columnsPSIEntry
+	rows = make([][]string, len(es))
+	for i, elem := range es {
+		row := make([]string, len(columnsPSIEntry))
+		row[0] = elem.Resource
+
+		row[1] = fmt.Sprintf("%3.2f %%", elem.SomeAvg10)
+		row[2] = fmt.Sprintf("%3.2f %%", elem.SomeAvg60)
+		row[3] = fmt.Sprintf("%3.2f %%", elem.SomeAvg300)
+		row[4] = elem.SomeTotal
+
+		row[5] = fmt.Sprintf("%3.2f %%", elem.FullAvg10)
+		row[6] = fmt.Sprintf("%3.2f %%", elem.FullAvg60)
+		row[7] = fmt.Sprintf("%3.2f %%", elem.FullAvg300)
+		row[8] = elem.FullTotal
+
+		row[9] = fmt.Sprintf("%d", elem.SomeTotalNum)
+		row[10] = fmt.Sprintf("%d", elem.FullTotalNum)
+
+		rows[i] = row
+	}
+	return
+}
+
+// StringPSI converts in print-friendly format.
+func StringPSI(header []string, rows [][]string, topLimit int) string {
+	buf := new(bytes.Buffer)
+	tw := tablewriter.NewWriter(buf)
+	tw.SetHeader(header[:columnsPSIToShow:columnsPSIToShow])
+
+	if topLimit > 0 && len(rows) > topLimit {
+		rows = rows[:topLimit:topLimit]
+	}
+
+	for _, row := range rows {
+		tw.Append(row[:columnsPSIToShow:columnsPSIToShow])
+	}
+	tw.SetAutoFormatHeaders(false)
+	tw.SetAlignment(tablewriter.ALIGN_RIGHT)
+	tw.Render()
+
+	return buf.String()
+}
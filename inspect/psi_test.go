@@ -0,0 +1,19 @@
+package inspect
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestGetPSI(t *testing.T) {
+	es, err := GetPSI()
+	if err != nil {
+		t.Skip(err)
+	}
+	if len(es) != 3 || es[0].Resource != "cpu" || es[1].Resource != "memory" || es[2].Resource != "io" {
+		t.Fatalf("unexpected entries %+v", es)
+	}
+	hd, rows := ConvertPSI(es...)
+	txt := StringPSI(hd, rows, -1)
+	fmt.Println(txt)
+}
@@ -0,0 +1,271 @@
+package inspect
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	humanize "github.com/dustin/go-humanize"
+	"github.com/olekukonko/tablewriter"
+)
+
+// ProcessTree is a process with its child processes.
+type ProcessTree struct {
+	PSEntry
+
+	Children []*ProcessTree
+
+	// OpenFDs is the number of open file descriptors in
+	// '/proc/$PID/fd', zero if not readable.
+	OpenFDs uint64
+
+	// subtree aggregation of the process and all its descendants
+	Processes    uint64
+	TotalCPU     string
+	TotalVMRSS   string
+	TotalFD      uint64
+	TotalThreads uint64
+
+	// extra fields for sorting
+	TotalCPUNum   float64
+	TotalVMRSSNum uint64
+
+	// parent is nil for the roots, including processes
+	// whose 'PPID' would make a cycle
+	parent *ProcessTree
+}
+
+// GetProcessTree builds the process trees of all processes from
+// 'PPID'. Processes whose parent is not found (e.g. PID 1 and 2)
+// are the roots. With 'WithPID', it returns the tree rooted at
+// the PID. With 'WithProgram', it returns the trees rooted at
+// the matching processes, excluding ones with matching ancestor.
+func GetProcessTree(opts ...OpFunc) ([]*ProcessTree, error) {
+	op := &EntryOp{}
+	op.applyOpts(opts)
+
+	// tree needs all processes
+	pss, err := GetPS(
+		WithTopExecPath(op.TopExecPath),
+		WithTopStream(op.TopStream),
+		WithCPUSampler(op.CPUSampler),
+	)
+	if err != nil {
+		return nil, err
+	}
+	pids := make([]int64, len(pss))
+	for i, p := range pss {
+		pids[i] = p.PID
+	}
+	nodes := buildProcessTree(pss, countOpenFDs(pids))
+
+	var roots []*ProcessTree
+	switch {
+	case op.PID > 0:
+		t, ok := nodes[op.PID]
+		if !ok {
+			return nil, fmt.Errorf("PID %d was not found", op.PID)
+		}
+		roots = append(roots, t)
+
+	case op.ProgramMatchFunc != nil:
+		for _, t := range nodes {
+			if !op.ProgramMatchFunc(t.Program) {
+				continue
+			}
+			matched := false
+			for p := t.parent; p != nil; p = p.parent {
+				if op.ProgramMatchFunc(p.Program) {
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				roots = append(roots, t)
+			}
+		}
+
+	default:
+		for _, t := range nodes {
+			if t.parent == nil {
+				roots = append(roots, t)
+			}
+		}
+	}
+	sortProcessTrees(roots)
+
+	if op.TopLimit > 0 && len(roots) > op.TopLimit {
+		roots = roots[:op.TopLimit:op.TopLimit]
+	}
+	return roots, nil
+}
+
+// buildProcessTree links each process to its parent,
+// and aggregates the subtrees with the open file descriptor
+// counts of each PID. Since the processes are not read at once,
+// a reused PID may make a 'PPID' cycle, in which case the link
+// that closes the cycle is dropped.
+func buildProcessTree(pss []PSEntry, openFDs map[int64]uint64) map[int64]*ProcessTree {
+	nodes := make(map[int64]*ProcessTree, len(pss))
+	ts := make([]*ProcessTree, 0, len(pss))
+	for _, p := range pss {
+		t := &ProcessTree{PSEntry: p, OpenFDs: openFDs[p.PID]}
+		nodes[p.PID] = t
+		ts = append(ts, t)
+	}
+	// link in PID order, so that the same link is dropped
+	sortProcessTrees(ts)
+	for _, t := range ts {
+		p, ok := nodes[t.PPID]
+		if !ok || p == t {
+			continue
+		}
+		visited := map[int64]struct{}{t.PID: {}}
+		cycle := false
+		for a := p; a != nil; a = a.parent {
+			if _, ok := visited[a.PID]; ok {
+				cycle = true
+				break
+			}
+			visited[a.PID] = struct{}{}
+		}
+		if cycle {
+			continue
+		}
+		t.parent = p
+		p.Children = append(p.Children, t)
+	}
+	for _, t := range ts {
+		sortProcessTrees(t.Children)
+	}
+	for _, t := range ts {
+		if t.parent == nil {
+			t.aggregate()
+		}
+	}
+	return nodes
+}
+
+// aggregate sums up the resource usage of the subtree.
+func (t *ProcessTree) aggregate() {
+	t.Processes = 1
+	t.TotalCPUNum = t.CPUNum
+	t.TotalVMRSSNum = t.VMRSSNum
+	t.TotalFD = t.OpenFDs
+	t.TotalThreads = t.Threads
+	for _, c := range t.Children {
+		c.aggregate()
+		t.Processes += c.Processes
+		t.TotalCPUNum += c.TotalCPUNum
+		t.TotalVMRSSNum += c.TotalVMRSSNum
+		t.TotalFD += c.TotalFD
+		t.TotalThreads += c.TotalThreads
+	}
+	t.TotalCPU = fmt.Sprintf("%3.2f %%", t.TotalCPUNum)
+	t.TotalVMRSS = humanize.Bytes(t.TotalVMRSSNum)
+}
+
+// Walk calls 'fn' for the process and its descendants in
+// depth-first order, where 'depth' is 0 for the process itself.
+func (t *ProcessTree) Walk(fn func(t *ProcessTree, depth int)) {
+	t.walk(fn, 0)
+}
+
+func (t *ProcessTree) walk(fn func(t *ProcessTree, depth int), depth int) {
+	fn(t, depth)
+	for _, c := range t.Children {
+		c.walk(fn, depth+1)
+	}
+}
+
+func sortProcessTrees(ts []*ProcessTree) {
+	sort.Slice(ts, func(i, j int) bool { return ts[i].PID < ts[j].PID })
+}
+
+const columnsProcessTreeToShow = 12
+
+var columnsProcessTreeEntry = []string{
+	"PROGRAM",
+
+	"STATE",
+	"PID",
+	"PPID",
+
+	"CPU",
+	"VMRSS",
+	"THREADS",
+
+	"PROCESSES",
+	"TOTAL-CPU",
+	"TOTAL-VMRSS",
+	"TOTAL-FD",
+	"TOTAL-THREADS",
+
+	// extra for sorting
+	"TOTAL-CPU-NUM",
+	"TOTAL-VMRSS-NUM",
+}
+
+// ConvertProcessTree converts to rows, in depth-first order.
+// Program names are indented by the depth in the tree.
+func ConvertProcessTree(ts ...*ProcessTree) (header []string, rows [][]string) {
+	header = columnsProcessTreeEntry
+	for _, t := range ts {
+		t.Walk(func(elem *ProcessTree, depth int) {
+			row := make([]string, len(columnsProcessTreeEntry))
+			row[0] = elem.Program
+			if depth > 0 {
+				row[0] = strings.Repeat("  ", depth-1) + "└─ " + elem.Program
+			}
+
+			row[1] = elem.State
+			row[2] = fmt.Sprintf("%d", elem.PID)
+			row[3] = fmt.Sprintf("%d", elem.PPID)
+
+			row[4] = elem.CPU
+			row[5] = elem.VMRSS
+			row[6] = fmt.Sprintf("%d", elem.Threads)
+
+			row[7] = fmt.Sprintf("%d", elem.Processes)
+			row[8] = elem.TotalCPU
+			row[9] = elem.TotalVMRSS
+			row[10] = fmt.Sprintf("%d", elem.TotalFD)
+			row[11] = fmt.Sprintf("%d", elem.TotalThreads)
+
+			row[12] = fmt.Sprintf("%3.2f", elem.TotalCPUNum)
+			row[13] = fmt.Sprintf("%d", elem.TotalVMRSSNum)
+
+			rows = append(rows, row)
+		})
+	}
+	return
+}
+
+// StringProcessTree converts in print-friendly format.
+func StringProcessTree(header []string, rows [][]string, topLimit int) string {
+	buf := new(bytes.Buffer)
+	tw := tablewriter.NewWriter(buf)
+	tw.SetHeader(header[:columnsProcessTreeToShow:columnsProcessTreeToShow])
+
+	if topLimit > 0 && len(rows) > topLimit {
+		rows = rows[:topLimit:topLimit]
+	}
+
+	for _, row := range rows {
+		tw.Append(row[:columnsProcessTreeToShow:columnsProcessTreeToShow])
+	}
+	tw.SetAutoFormatHeaders(false)
+	tw.SetAlignment(tablewriter.ALIGN_RIGHT)
+
+	// left-align program names to show the tree
+	aligns := make([]int, columnsProcessTreeToShow)
+	for i := range aligns {
+		aligns[i] = tablewriter.ALIGN_RIGHT
+	}
+	aligns[0] = tablewriter.ALIGN_LEFT
+	tw.SetColumnAlignment(aligns)
+	tw.Render()
+
+	return buf.String()
+}
@@ -0,0 +1,94 @@
+package inspect
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"testing"
+)
+
+func TestBuildProcessTree(t *testing.T) {
+	nodes := buildProcessTree([]PSEntry{
+		// 'FD' is the fd table size, not aggregated
+		{Program: "init", PID: 1, PPID: 0, CPUNum: 1, VMRSSNum: 100, FD: 64, Threads: 1},
+		{Program: "master", PID: 10, PPID: 1, CPUNum: 2, VMRSSNum: 200, FD: 64, Threads: 2},
+		{Program: "worker", PID: 12, PPID: 10, CPUNum: 3, VMRSSNum: 300, FD: 64, Threads: 3},
+		{Program: "worker", PID: 11, PPID: 10, CPUNum: 4, VMRSSNum: 400, FD: 64, Threads: 4},
+		{Program: "kthreadd", PID: 2, PPID: 0},
+	}, map[int64]uint64{1: 1, 10: 2, 12: 3, 11: 4})
+
+	master := nodes[10]
+	if len(master.Children) != 2 || master.Children[0].PID != 11 || master.Children[1].PID != 12 {
+		t.Fatalf("unexpected children %+v", master.Children)
+	}
+	if master.Processes != 3 || master.TotalCPUNum != 9 || master.TotalVMRSSNum != 900 || master.TotalFD != 9 || master.TotalThreads != 9 {
+		t.Fatalf("unexpected aggregation %+v", master)
+	}
+	if nodes[1].Processes != 4 || nodes[1].TotalVMRSSNum != 1000 {
+		t.Fatalf("unexpected aggregation %+v", nodes[1])
+	}
+
+	var pids []int64
+	nodes[1].Walk(func(t *ProcessTree, depth int) { pids = append(pids, t.PID) })
+	if fmt.Sprint(pids) != "[1 10 11 12]" {
+		t.Fatalf("unexpected walk order %v", pids)
+	}
+
+	hd, rows := ConvertProcessTree(nodes[1])
+	if len(rows) != 4 || rows[2][0] != "  └─ worker" {
+		t.Fatalf("unexpected rows %q", rows)
+	}
+	fmt.Println(StringProcessTree(hd, rows, -1))
+}
+
+func TestBuildProcessTreeCycle(t *testing.T) {
+	// PID 20 exited and was reused by a child of PID 21
+	nodes := buildProcessTree([]PSEntry{
+		{Program: "init", PID: 1, PPID: 0},
+		{Program: "a", PID: 20, PPID: 21},
+		{Program: "b", PID: 21, PPID: 20},
+		{Program: "c", PID: 22, PPID: 21},
+	}, nil)
+
+	// links are made in PID order, so 21 -> 20 closes the cycle
+	if nodes[21].parent != nil || nodes[20].parent != nodes[21] {
+		t.Fatalf("expected PID 21 to be the root, got %+v", nodes[21])
+	}
+	if nodes[21].Processes != 3 {
+		t.Fatalf("unexpected aggregation %+v", nodes[21])
+	}
+
+	var pids []int64
+	nodes[21].Walk(func(t *ProcessTree, depth int) { pids = append(pids, t.PID) })
+	if fmt.Sprint(pids) != "[21 20 22]" {
+		t.Fatalf("unexpected walk order %v", pids)
+	}
+}
+
+func TestGetProcessTree(t *testing.T) {
+	cmd := exec.Command("sleep", "10")
+	if err := cmd.Start(); err != nil {
+		t.Skip(err)
+	}
+	defer cmd.Process.Kill()
+
+	pid := int64(os.Getpid())
+	ts, err := GetProcessTree(WithPID(pid))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ts) != 1 || ts[0].PID != pid {
+		t.Fatalf("unexpected trees %+v", ts)
+	}
+	found := false
+	for _, c := range ts[0].Children {
+		if c.PID == int64(cmd.Process.Pid) {
+			found = true
+		}
+	}
+	if !found || ts[0].Processes < 2 || ts[0].TotalVMRSSNum < ts[0].VMRSSNum {
+		t.Fatalf("child %d not found in %+v", cmd.Process.Pid, ts[0])
+	}
+	hd, rows := ConvertProcessTree(ts...)
+	fmt.Println(StringProcessTree(hd, rows, -1))
+}
@@ -0,0 +1,104 @@
+package inspect
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/gyuho/linux-inspect/proc"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+// RouteEntry represents a route.
+// Simplied from 'NetRoute'.
+type RouteEntry struct {
+	// Family is 'ipv4' or 'ipv6'.
+	Family string
+
+	// Destination is in CIDR notation (e.g. '192.0.2.0/24'), and
+	// Gateway is in 'net.IP' format (e.g. 'fe80::1'), same as 'SSEntry'.
+	Destination string
+	Gateway     string
+	Flags       string
+	Metric      uint64
+	Interface   string
+}
+
+// GetRoute lists all routes in '/proc/net/route' and '/proc/net/ipv6_route'.
+func GetRoute() ([]RouteEntry, error) {
+	rs, err := proc.GetNetRoute()
+	if err != nil {
+		return nil, err
+	}
+	es := convertNetRoute("ipv4", rs)
+
+	// '/proc/net/ipv6_route' does not exist if IPv6 is disabled
+	rs, err = proc.GetNetIPv6Route()
+	if err != nil {
+		if os.IsNotExist(err) {
+			return es, nil
+		}
+		return nil, err
+	}
+	return append(es, convertNetRoute("ipv6", rs)...), nil
+}
+
+func convertNetRoute(family string, rs []proc.NetRoute) []RouteEntry {
+	es := make([]RouteEntry, len(rs))
+	for i, r := range rs {
+		es[i] = RouteEntry{
+			Family:      family,
+			Destination: fmt.Sprintf("%s/%d", normalizeIP(r.Destination), r.PrefixLength),
+			Gateway:     normalizeIP(r.Gateway),
+			Flags:       r.FlagsParsed,
+			Metric:      r.Metric,
+			Interface:   r.Interface,
+		}
+	}
+	return es
+}
+
+const columnsRouteToShow = 6
+
+var columnsRouteEntry = []string{
+	"FAMILY",
+	"DESTINATION", "GATEWAY", "FLAGS", "METRIC", "INTERFACE",
+}
+
+// ConvertRoute converts to rows, in the order of the routing tables.
+func ConvertRoute(es ...RouteEntry) (header []string, rows [][]string) {
+	header = columnsRouteEntry
+	rows = make([][]string, len(es))
+	for i, elem := range es {
+		row := make([]string, len(columnsRouteEntry))
+		row[0] = elem.Family
+		row[1] = elem.Destination
+		row[2] = elem.Gateway
+		row[3] = elem.Flags
+		row[4] = fmt.Sprintf("%d", elem.Metric)
+		row[5] = elem.Interface
+		rows[i] = row
+	}
+	return
+}
+
+// StringRoute converts in print-friendly format.
+func StringRoute(header []string, rows [][]string, topLimit int) string {
+	buf := new(bytes.Buffer)
+	tw := tablewriter.NewWriter(buf)
+	tw.SetHeader(header[:columnsRouteToShow:columnsRouteToShow])
+
+	if topLimit > 0 && len(rows) > topLimit {
+		rows = rows[:topLimit:topLimit]
+	}
+
+	for _, row := range rows {
+		tw.Append(row[:columnsRouteToShow:columnsRouteToShow])
+	}
+	tw.SetAutoFormatHeaders(false)
+	tw.SetAlignment(tablewriter.ALIGN_RIGHT)
+	tw.Render()
+
+	return buf.String()
+}
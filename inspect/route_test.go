@@ -0,0 +1,36 @@
+package inspect
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gyuho/linux-inspect/proc"
+)
+
+func TestConvertNetRoute(t *testing.T) {
+	es := convertNetRoute("ipv4", []proc.NetRoute{
+		{Interface: "eth0", Destination: "192.0.2.0", PrefixLength: 24, Mask: "255.255.255.0", Gateway: "0.0.0.0", Metric: 100, FlagsParsed: "U"},
+	})
+	exp := RouteEntry{Family: "ipv4", Destination: "192.0.2.0/24", Gateway: "0.0.0.0", Flags: "U", Metric: 100, Interface: "eth0"}
+	if len(es) != 1 || es[0] != exp {
+		t.Fatalf("expected %+v, got %+v", exp, es)
+	}
+
+	es = convertNetRoute("ipv6", []proc.NetRoute{
+		{Interface: "eth0", Destination: "fe80:0000:0000:0000:0000:0000:0000:0000", PrefixLength: 64, Gateway: "0000:0000:0000:0000:0000:0000:0000:0000", Metric: 256, FlagsParsed: "U"},
+	})
+	exp = RouteEntry{Family: "ipv6", Destination: "fe80::/64", Gateway: "::", Flags: "U", Metric: 256, Interface: "eth0"}
+	if len(es) != 1 || es[0] != exp {
+		t.Fatalf("expected %+v, got %+v", exp, es)
+	}
+}
+
+func TestGetRoute(t *testing.T) {
+	es, err := GetRoute()
+	if err != nil {
+		t.Skip(err)
+	}
+	hd, rows := ConvertRoute(es...)
+	txt := StringRoute(hd, rows, -1)
+	fmt.Println(txt)
+}
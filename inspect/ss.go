@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"fmt"
 	"log"
+	"net"
 	"os/user"
 	"sync"
+	"time"
 
 	"github.com/gyuho/linux-inspect/proc"
 
@@ -14,7 +16,7 @@ import (
 )
 
 // SSEntry is a socket entry.
-// Simplied from 'NetTCP'.
+// Simplied from 'NetTCP', 'NetUnix' and 'sockdiag.Entry'.
 type SSEntry struct {
 	Protocol string
 
@@ -22,123 +24,228 @@ type SSEntry struct {
 	State   string
 	PID     int64
 
+	// LocalIP and RemoteIP are in 'net.IP' format (e.g. '::1'),
+	// regardless of whether read from '/proc' or netlink.
 	LocalIP   string
 	LocalPort int64
 
 	RemoteIP   string
 	RemotePort int64
 
+	// Path is the bound path of UNIX domain socket.
+	Path string
+
 	User user.User
+
+	// Inode is the socket inode, matched against
+	// 'socket:[inode]' links in '/proc/$PID/fd'.
+	Inode string
+
+	// tcp_info fields, only available for
+	// TCP sockets with 'WithNetlink'.
+	HasTCPInfo   bool
+	RTT          string
+	Cwnd         uint32
+	Retransmits  uint32
+	BytesAcked   string
+	DeliveryRate string
+
+	// extra fields for sorting
+	RTTNum          time.Duration
+	BytesAckedNum   uint64
+	DeliveryRateNum uint64
 }
 
 // GetSS finds all SSEntry by given filter.
+// Each socket is attributed to the processes that hold its file
+// descriptor, so a socket shared by multiple processes is listed once
+// per owner. Sockets with no owner are skipped unless 'WithUnowned'.
+// Socket tables in '/proc/$PID/net' are read once per network namespace.
+// With 'WithNetlink', TCP and UDP sockets are read with 'NETLINK_SOCK_DIAG'
+// in the network namespace of the current process, and it falls back
+// to '/proc' if netlink is not available.
 func GetSS(opts ...OpFunc) (sss []SSEntry, err error) {
 	ft := &EntryOp{}
 	ft.applyOpts(opts)
 
 	var pids []int64
-	switch {
-	case ft.ProgramMatchFunc == nil && ft.PID < 1:
-		// get all PIDs
-		pids, err = proc.ListPIDs()
-		if err != nil {
-			return
-		}
-
-	case ft.PID > 0:
+	if ft.PID > 0 {
 		pids = []int64{ft.PID}
-
-	case ft.ProgramMatchFunc != nil:
-		// later to find PIDs by Program
-		pids = nil
-
-	default:
-		// applyOpts already panic when ft.ProgramMatchFunc != nil && ft.PID > 0
+	} else if pids, err = proc.ListPIDs(); err != nil {
+		return
 	}
 
-	if len(pids) == 0 {
-		// find PIDs by Program
-		if pids, err = proc.ListPIDs(); err != nil {
-			return
-		}
+	// map socket inodes to owning PIDs; with 'WithUnowned', read the fds
+	// of all processes, so that sockets held by other processes are
+	// not reported as unowned
+	var owners map[string][]int64
+	if ft.PID > 0 && !ft.Unowned {
+		owners, err = proc.GetSocketInodesByPID(ft.PID)
 	} else {
-		// already know PIDs to query
-		ft.ProgramMatchFunc = func(string) bool { return true }
+		owners, err = proc.GetSocketInodes()
+	}
+	if err != nil {
+		return
+	}
+	hasSocket := make(map[int64]bool)
+	for _, ps := range owners {
+		for _, pid := range ps {
+			hasSocket[pid] = true
+		}
 	}
 
+	// find PIDs matching the filter
 	var pmu sync.RWMutex
+	programs := make(map[int64]string)
 	var wg sync.WaitGroup
 	limitc := make(chan struct{}, maxConcurrentProcFDLimit)
+	wg.Add(len(pids))
+	for _, pid := range pids {
+		go func(pid int64) {
+			defer func() {
+				<-limitc
+				wg.Done()
+			}()
+			limitc <- struct{}{}
+
+			if !hasSocket[pid] && !ft.Unowned {
+				return
+			}
+			stat, err := proc.GetStatByPID(pid)
+			if err != nil {
+				log.Printf("proc.GetStatByPID error %v for PID %d", err, pid)
+				return
+			}
+			if ft.ProgramMatchFunc != nil && !ft.ProgramMatchFunc(stat.Comm) {
+				return
+			}
+			if ft.hasProcessMatch() {
+				args, _ := proc.GetCmdline(pid)
+				exe, _ := proc.GetExe(pid)
+				if !ft.matchProcess(args, exe) {
+					return
+				}
+			}
+			pmu.Lock()
+			programs[pid] = stat.Comm
+			pmu.Unlock()
+		}(pid)
+	}
+	wg.Wait()
 
-	f := func(pid int64, ttype proc.TransportProtocol) {
+	// socket tables are per network namespace, so the same socket
+	// can be listed by multiple PIDs; dedupe by protocol and inode
+	seen := make(map[string]struct{})
+	var socks []SSEntry
+	add := func(ents []SSEntry) {
+		for _, ent := range ents {
+			k := ssEntryKey(ent)
+			if _, ok := seen[k]; ok {
+				continue
+			}
+			seen[k] = struct{}{}
+			socks = append(socks, ent)
+		}
+	}
+
+	tps := ft.protocols()
+	if ft.Netlink {
+		var rest []proc.TransportProtocol
+		for _, tp := range tps {
+			ents, err := getSSEntryNetlink(tp, ft.LocalPort, ft.RemotePort)
+			if err != nil {
+				// fall back to '/proc'
+				rest = append(rest, tp)
+				continue
+			}
+			add(ents)
+		}
+		tps = rest
+	}
+
+	f := func(pid int64, tp proc.TransportProtocol) {
 		defer func() {
 			<-limitc
 			wg.Done()
 		}()
 		limitc <- struct{}{}
 
-		stat, err := proc.GetStatByPID(pid)
-		if err != nil {
-			log.Printf("proc.GetStatByPID error %v for PID %d", err, pid)
-			return
-		}
-		if !ft.ProgramMatchFunc(stat.Comm) {
-			return
-		}
-
-		pmu.RLock()
-		done := ft.TopLimit > 0 && len(sss) >= ft.TopLimit
-		pmu.RUnlock()
-		if done {
-			return
-		}
-
-		ents, err := getSSEntry(pid, ttype, ft.LocalPort, ft.RemotePort)
+		ents, err := getSSEntry(pid, tp, ft.LocalPort, ft.RemotePort)
 		if err != nil {
 			log.Printf("getSSEntry error %v for PID %d", err, pid)
 			return
 		}
 
 		pmu.Lock()
-		sss = append(sss, ents...)
+		add(ents)
 		pmu.Unlock()
 	}
 
-	wg.Add(len(pids))
-	if ft.TCP && ft.TCP6 {
-		wg.Add(len(pids))
+	// read the socket tables once per network namespace
+	filtered := make([]int64, 0, len(programs))
+	for pid := range programs {
+		filtered = append(filtered, pid)
 	}
-	for _, pid := range pids {
-		if ft.TCP {
-			go f(pid, proc.TypeTCP)
-		}
-		if ft.TCP6 {
-			go f(pid, proc.TypeTCP6)
+	nets := netNamespacePIDs(filtered)
+	wg.Add(len(nets) * len(tps))
+	for pid := range nets {
+		for _, tp := range tps {
+			go f(pid, tp)
 		}
 	}
 	wg.Wait()
 
+	// attribute each socket to its owners
+	for _, sock := range socks {
+		if ft.TopLimit > 0 && len(sss) >= ft.TopLimit {
+			break
+		}
+		matched := false
+		for _, pid := range owners[sock.Inode] {
+			pname, ok := programs[pid]
+			if !ok {
+				// owner does not match the filter
+				continue
+			}
+			ent := sock
+			ent.PID = pid
+			ent.Program = pname
+			sss = append(sss, ent)
+			matched = true
+		}
+		if !matched && ft.Unowned && len(owners[sock.Inode]) == 0 {
+			sss = append(sss, sock)
+		}
+	}
+
 	if ft.TopLimit > 0 && len(sss) > ft.TopLimit {
 		sss = sss[:ft.TopLimit:ft.TopLimit]
 	}
 	return
 }
 
+// ssEntryKey identifies a socket across network namespace tables.
+// Sockets in TIME_WAIT have no inode ('0').
+func ssEntryKey(ent SSEntry) string {
+	if ent.Inode != "" && ent.Inode != "0" {
+		return ent.Protocol + "/" + ent.Inode
+	}
+	return fmt.Sprintf("%s/%s/%s:%d/%s:%d/%s", ent.Protocol, ent.State, ent.LocalIP, ent.LocalPort, ent.RemoteIP, ent.RemotePort, ent.Path)
+}
+
+// getSSEntry reads the socket table of the network namespace of the PID,
+// without owner information.
 func getSSEntry(pid int64, tp proc.TransportProtocol, lport int64, rport int64) (sss []SSEntry, err error) {
+	if tp == proc.TypeUnix {
+		return getSSEntryUnix(pid, lport, rport)
+	}
+
 	nss, nerr := proc.GetNetTCPByPID(pid, tp)
 	if nerr != nil {
 		return nil, nerr
 	}
-	pname, perr := proc.GetProgram(pid)
-	if perr != nil {
-		return nil, perr
-	}
 
 	for _, elem := range nss {
-		u, uerr := user.LookupId(fmt.Sprintf("%d", elem.Uid))
-		if uerr != nil {
-			return nil, uerr
-		}
 		if lport > 0 && lport != elem.LocalAddressParsedIPPort {
 			continue
 		}
@@ -148,17 +255,17 @@ func getSSEntry(pid int64, tp proc.TransportProtocol, lport int64, rport int64)
 		entry := SSEntry{
 			Protocol: elem.Type,
 
-			Program: pname,
-			State:   elem.StParsedStatus,
-			PID:     pid,
+			State: elem.StParsedStatus,
 
-			LocalIP:   elem.LocalAddressParsedIPHost,
+			LocalIP:   normalizeIP(elem.LocalAddressParsedIPHost),
 			LocalPort: elem.LocalAddressParsedIPPort,
 
-			RemoteIP:   elem.RemAddressParsedIPHost,
+			RemoteIP:   normalizeIP(elem.RemAddressParsedIPHost),
 			RemotePort: elem.RemAddressParsedIPPort,
 
-			User: *u,
+			User: lookupUser(elem.Uid),
+
+			Inode: elem.Inode,
 		}
 		sss = append(sss, entry)
 	}
@@ -166,7 +273,68 @@ func getSSEntry(pid int64, tp proc.TransportProtocol, lport int64, rport int64)
 	return
 }
 
-const columnsSSToShow = 9
+// normalizeIP converts the uncompressed ipv6 addresses in '/proc/net/tcp6'
+// (e.g. '0000:0000:0000:0000:0000:0000:0000:0001') to 'net.IP' format
+// (e.g. '::1'), as in 'sockdiag.Entry'.
+func normalizeIP(s string) string {
+	ip := net.ParseIP(s)
+	if ip == nil {
+		return s
+	}
+	return ip.String()
+}
+
+func getSSEntryUnix(pid int64, lport int64, rport int64) (sss []SSEntry, err error) {
+	if lport > 0 || rport > 0 {
+		// UNIX domain sockets have no port
+		return nil, nil
+	}
+	nus, nerr := proc.GetNetUnixByPID(pid)
+	if nerr != nil {
+		return nil, nerr
+	}
+
+	for _, elem := range nus {
+		entry := SSEntry{
+			Protocol: proc.TypeUnix.String(),
+
+			State: elem.StParsedStatus,
+
+			Path: elem.Path,
+
+			Inode: elem.Inode,
+		}
+		sss = append(sss, entry)
+	}
+	return
+}
+
+var (
+	usersMu sync.Mutex
+	users   = make(map[uint64]user.User)
+)
+
+// lookupUser caches user lookups. Unknown users
+// (e.g. only defined in a container) only have 'Uid'.
+func lookupUser(uid uint64) user.User {
+	usersMu.Lock()
+	defer usersMu.Unlock()
+
+	if u, ok := users[uid]; ok {
+		return u
+	}
+	u := user.User{Uid: fmt.Sprintf("%d", uid)}
+	if lu, err := user.LookupId(u.Uid); err == nil {
+		u = *lu
+	}
+	users[uid] = u
+	return u
+}
+
+const (
+	columnsSSToShow        = 10
+	columnsSSTCPInfoToShow = 15
+)
 
 var columnsSSEntry = []string{
 	"PROTOCOL",
@@ -182,6 +350,18 @@ var columnsSSEntry = []string{
 	"REMOTE-PORT",
 
 	"USER",
+	"PATH",
+
+	"RTT",
+	"CWND",
+	"RETRANSMITS",
+	"BYTES-ACKED",
+	"DELIVERY-RATE",
+
+	// extra for sorting
+	"RTT-NUM",
+	"BYTES-ACKED-NUM",
+	"DELIVERY-RATE-NUM",
 }
 
 // ConvertSS converts to rows.
@@ -203,6 +383,19 @@ func ConvertSS(nss ...SSEntry) (header []string, rows [][]string) {
 		row[7] = fmt.Sprintf("%d", elem.RemotePort)
 
 		row[8] = elem.User.Username
+		row[9] = elem.Path
+
+		if elem.HasTCPInfo {
+			row[10] = elem.RTT
+			row[11] = fmt.Sprintf("%d", elem.Cwnd)
+			row[12] = fmt.Sprintf("%d", elem.Retransmits)
+			row[13] = elem.BytesAcked
+			row[14] = elem.DeliveryRate
+
+			row[15] = fmt.Sprintf("%d", elem.RTTNum)
+			row[16] = fmt.Sprintf("%d", elem.BytesAckedNum)
+			row[17] = fmt.Sprintf("%d", elem.DeliveryRateNum)
+		}
 
 		rows[i] = row
 	}
@@ -219,17 +412,26 @@ func ConvertSS(nss ...SSEntry) (header []string, rows [][]string) {
 }
 
 // StringSS converts in print-friendly format.
+// tcp_info columns are shown only when any row has them.
 func StringSS(header []string, rows [][]string, topLimit int) string {
-	buf := new(bytes.Buffer)
-	tw := tablewriter.NewWriter(buf)
-	tw.SetHeader(header[:columnsSSToShow:columnsSSToShow])
-
 	if topLimit > 0 && len(rows) > topLimit {
 		rows = rows[:topLimit:topLimit]
 	}
 
+	n := columnsSSToShow
+	for _, row := range rows {
+		if len(row) >= columnsSSTCPInfoToShow && row[10] != "" {
+			n = columnsSSTCPInfoToShow
+			break
+		}
+	}
+
+	buf := new(bytes.Buffer)
+	tw := tablewriter.NewWriter(buf)
+	tw.SetHeader(header[:n:n])
+
 	for _, row := range rows {
-		tw.Append(row[:columnsSSToShow:columnsSSToShow])
+		tw.Append(row[:n:n])
 	}
 	tw.SetAutoFormatHeaders(false)
 	tw.SetAlignment(tablewriter.ALIGN_RIGHT)
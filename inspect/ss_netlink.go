@@ -0,0 +1,73 @@
+package inspect
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gyuho/linux-inspect/proc"
+	"github.com/gyuho/linux-inspect/sockdiag"
+
+	humanize "github.com/dustin/go-humanize"
+	"golang.org/x/sys/unix"
+)
+
+// getSSEntryNetlink dumps the sockets in the network namespace of
+// the current process with 'NETLINK_SOCK_DIAG', without owner
+// information. It returns an error for protocols not supported
+// by 'inet_diag', so that the caller falls back to '/proc'.
+func getSSEntryNetlink(tp proc.TransportProtocol, lport int64, rport int64) (sss []SSEntry, err error) {
+	var ents []sockdiag.Entry
+	switch tp {
+	case proc.TypeTCP:
+		ents, err = sockdiag.GetTCP(unix.AF_INET)
+	case proc.TypeTCP6:
+		ents, err = sockdiag.GetTCP(unix.AF_INET6)
+	case proc.TypeUDP:
+		ents, err = sockdiag.GetUDP(unix.AF_INET)
+	case proc.TypeUDP6:
+		ents, err = sockdiag.GetUDP(unix.AF_INET6)
+	default:
+		return nil, fmt.Errorf("%q is not supported by netlink", tp)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, elem := range ents {
+		if lport > 0 && lport != int64(elem.SrcPort) {
+			continue
+		}
+		if rport > 0 && rport != int64(elem.DstPort) {
+			continue
+		}
+		entry := SSEntry{
+			Protocol: tp.String(),
+
+			State: elem.StateString(),
+
+			LocalIP:   elem.SrcIP.String(),
+			LocalPort: int64(elem.SrcPort),
+
+			RemoteIP:   elem.DstIP.String(),
+			RemotePort: int64(elem.DstPort),
+
+			User: lookupUser(uint64(elem.UID)),
+
+			Inode: fmt.Sprintf("%d", elem.Inode),
+		}
+		if info := elem.TCPInfo; info != nil {
+			entry.HasTCPInfo = true
+			entry.RTT = (time.Duration(info.RTT) * time.Microsecond).String()
+			entry.Cwnd = info.SndCwnd
+			entry.Retransmits = info.TotalRetrans
+			entry.BytesAcked = humanize.Bytes(info.BytesAcked)
+			entry.DeliveryRate = humanize.Bytes(info.DeliveryRate) + "/s"
+
+			entry.RTTNum = time.Duration(info.RTT) * time.Microsecond
+			entry.BytesAckedNum = info.BytesAcked
+			entry.DeliveryRateNum = info.DeliveryRate
+		}
+		sss = append(sss, entry)
+	}
+	return
+}
@@ -0,0 +1,191 @@
+package inspect
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"sort"
+
+	"github.com/gyuho/linux-inspect/proc"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+// Groups of 'SSCount'.
+const (
+	SSGroupState          = "STATE"
+	SSGroupProtocol       = "PROTOCOL"
+	SSGroupLocalPort      = "LOCAL-PORT"
+	SSGroupRemoteIP       = "REMOTE-IP"
+	SSGroupLocalPortState = "LOCAL-PORT-STATE"
+)
+
+// SSCount is the number of sockets with the same key in a group
+// (e.g. 'LOCAL-PORT-STATE' group with key '8080 TIME_WAIT').
+type SSCount struct {
+	Group string
+	Key   string
+	Count int
+}
+
+// SummarizeSS counts 'SSEntry' by state, protocol, local port,
+// remote IP, and local port with state to find TIME_WAIT or CLOSE_WAIT
+// pileups per port. A socket listed once per owner in 'GetSS' is
+// counted once. Ports and IPs of UNIX domain sockets are not counted.
+// Counts are sorted by group, and in descending order of count.
+func SummarizeSS(sss ...SSEntry) []SSCount {
+	groups := []string{SSGroupState, SSGroupProtocol, SSGroupLocalPort, SSGroupRemoteIP, SSGroupLocalPortState}
+	counts := make(map[string]map[string]int, len(groups))
+	for _, g := range groups {
+		counts[g] = make(map[string]int)
+	}
+
+	seen := make(map[string]struct{})
+	for _, ent := range sss {
+		k := ssEntryKey(ent)
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+
+		if ent.State != "" {
+			counts[SSGroupState][ent.State]++
+		}
+		counts[SSGroupProtocol][ent.Protocol]++
+		if ent.Protocol == "unix" {
+			continue
+		}
+		port := fmt.Sprintf("%d", ent.LocalPort)
+		counts[SSGroupLocalPort][port]++
+		counts[SSGroupLocalPortState][port+" "+ent.State]++
+		if ip := net.ParseIP(ent.RemoteIP); ip != nil && !ip.IsUnspecified() {
+			// skip listening and unconnected sockets
+			counts[SSGroupRemoteIP][ent.RemoteIP]++
+		}
+	}
+
+	var cs []SSCount
+	for _, g := range groups {
+		var gcs []SSCount
+		for k, n := range counts[g] {
+			gcs = append(gcs, SSCount{Group: g, Key: k, Count: n})
+		}
+		sort.Slice(gcs, func(i, j int) bool {
+			if gcs[i].Count != gcs[j].Count {
+				return gcs[i].Count > gcs[j].Count
+			}
+			return gcs[i].Key < gcs[j].Key
+		})
+		cs = append(cs, gcs...)
+	}
+	return cs
+}
+
+const columnsSSCountToShow = 3
+
+var columnsSSCount = []string{"GROUP", "KEY", "COUNT"}
+
+// ConvertSSCount converts to rows.
+func ConvertSSCount(cs ...SSCount) (header []string, rows [][]string) {
+	header = columnsSSCount
+	rows = make([][]string, len(cs))
+	for i, elem := range cs {
+		row := make([]string, len(columnsSSCount))
+		row[0] = elem.Group
+		row[1] = elem.Key
+		row[2] = fmt.Sprintf("%d", elem.Count)
+		rows[i] = row
+	}
+	return
+}
+
+// StringSSCount converts in print-friendly format.
+// With 'topLimit', each group is limited separately.
+func StringSSCount(header []string, rows [][]string, topLimit int) string {
+	buf := new(bytes.Buffer)
+	tw := tablewriter.NewWriter(buf)
+	tw.SetHeader(header[:columnsSSCountToShow:columnsSSCountToShow])
+
+	n := 0
+	for i, row := range rows {
+		if i > 0 && rows[i-1][0] != row[0] {
+			n = 0
+		}
+		n++
+		if topLimit > 0 && n > topLimit {
+			continue
+		}
+		tw.Append(row[:columnsSSCountToShow:columnsSSCountToShow])
+	}
+	tw.SetAutoFormatHeaders(false)
+	tw.SetAlignment(tablewriter.ALIGN_RIGHT)
+	tw.Render()
+
+	return buf.String()
+}
+
+const columnsSockstatToShow = 7
+
+// columnsSockstat shows 'MEM-PAGES' for sockets, and 'MEMORY-BYTES'
+// for IP fragment reassembly (FRAG, FRAG6), same as '/proc/net/sockstat'.
+var columnsSockstat = []string{
+	"PROTOCOL",
+	"INUSE", "ORPHAN", "TIME-WAIT", "ALLOC", "MEM-PAGES", "MEMORY-BYTES",
+}
+
+// ConvertSockstat converts to rows, one per protocol.
+func ConvertSockstat(st proc.Sockstat) (header []string, rows [][]string) {
+	header = columnsSockstat
+	ps := []struct {
+		name string
+		p    proc.SockstatProtocol
+		frag bool
+	}{
+		{"TCP", st.TCP, false},
+		{"UDP", st.UDP, false},
+		{"UDPLITE", st.UDPLite, false},
+		{"RAW", st.RAW, false},
+		{"FRAG", st.FRAG, true},
+		{"TCP6", st.TCP6, false},
+		{"UDP6", st.UDP6, false},
+		{"UDPLITE6", st.UDPLite6, false},
+		{"RAW6", st.RAW6, false},
+		{"FRAG6", st.FRAG6, true},
+	}
+	rows = make([][]string, len(ps))
+	for i, elem := range ps {
+		row := make([]string, len(columnsSockstat))
+		row[0] = elem.name
+		row[1] = fmt.Sprintf("%d", elem.p.InUse)
+		row[2] = fmt.Sprintf("%d", elem.p.Orphan)
+		row[3] = fmt.Sprintf("%d", elem.p.TW)
+		row[4] = fmt.Sprintf("%d", elem.p.Alloc)
+		if elem.frag {
+			row[6] = fmt.Sprintf("%d", elem.p.Memory)
+		} else {
+			row[5] = fmt.Sprintf("%d", elem.p.Mem)
+		}
+		rows[i] = row
+	}
+	return
+}
+
+// StringSockstat converts in print-friendly format.
+func StringSockstat(header []string, rows [][]string, topLimit int) string {
+	buf := new(bytes.Buffer)
+	tw := tablewriter.NewWriter(buf)
+	tw.SetHeader(header[:columnsSockstatToShow:columnsSockstatToShow])
+
+	if topLimit > 0 && len(rows) > topLimit {
+		rows = rows[:topLimit:topLimit]
+	}
+
+	for _, row := range rows {
+		tw.Append(row[:columnsSockstatToShow:columnsSockstatToShow])
+	}
+	tw.SetAutoFormatHeaders(false)
+	tw.SetAlignment(tablewriter.ALIGN_RIGHT)
+	tw.Render()
+
+	return buf.String()
+}
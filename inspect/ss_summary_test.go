@@ -0,0 +1,76 @@
+package inspect
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gyuho/linux-inspect/proc"
+)
+
+func TestSummarizeSS(t *testing.T) {
+	sss := []SSEntry{
+		{Protocol: "tcp", State: "LISTEN", LocalIP: "0.0.0.0", LocalPort: 8080, RemoteIP: "0.0.0.0", Inode: "100", PID: 1},
+		// same socket, listed once per owner
+		{Protocol: "tcp", State: "LISTEN", LocalIP: "0.0.0.0", LocalPort: 8080, RemoteIP: "0.0.0.0", Inode: "100", PID: 2},
+		{Protocol: "tcp", State: "TIME_WAIT", LocalIP: "10.0.0.1", LocalPort: 8080, RemoteIP: "10.0.0.2", RemotePort: 40001, Inode: "0"},
+		{Protocol: "tcp", State: "TIME_WAIT", LocalIP: "10.0.0.1", LocalPort: 8080, RemoteIP: "10.0.0.2", RemotePort: 40002, Inode: "0"},
+		{Protocol: "tcp", State: "TIME_WAIT", LocalIP: "10.0.0.1", LocalPort: 8080, RemoteIP: "10.0.0.3", RemotePort: 40003, Inode: "0"},
+		{Protocol: "tcp", State: "CLOSE_WAIT", LocalIP: "10.0.0.1", LocalPort: 9090, RemoteIP: "10.0.0.2", RemotePort: 50001, Inode: "101"},
+		{Protocol: "unix", State: "ESTABLISHED", Path: "/run/docker.sock", Inode: "102"},
+	}
+	cs := SummarizeSS(sss...)
+
+	get := func(group, key string) int {
+		for _, c := range cs {
+			if c.Group == group && c.Key == key {
+				return c.Count
+			}
+		}
+		return 0
+	}
+	tests := []struct {
+		group, key string
+		count      int
+	}{
+		{SSGroupState, "TIME_WAIT", 3},
+		{SSGroupState, "LISTEN", 1},
+		{SSGroupProtocol, "tcp", 5},
+		{SSGroupProtocol, "unix", 1},
+		{SSGroupLocalPort, "8080", 4},
+		{SSGroupLocalPort, "0", 0},
+		{SSGroupRemoteIP, "10.0.0.2", 3},
+		{SSGroupRemoteIP, "0.0.0.0", 0},
+		{SSGroupLocalPortState, "8080 TIME_WAIT", 3},
+		{SSGroupLocalPortState, "9090 CLOSE_WAIT", 1},
+	}
+	for i, tt := range tests {
+		if n := get(tt.group, tt.key); n != tt.count {
+			t.Fatalf("#%d: %s %q expected %d, got %d", i, tt.group, tt.key, tt.count, n)
+		}
+	}
+
+	// sorted by group, and in descending order of count
+	if cs[0].Group != SSGroupState || cs[0].Key != "TIME_WAIT" {
+		t.Fatalf("unexpected first count %+v", cs[0])
+	}
+
+	hd, rows := ConvertSSCount(cs...)
+	txt := StringSSCount(hd, rows, 2)
+	fmt.Println(txt)
+}
+
+func TestConvertSockstat(t *testing.T) {
+	st := proc.Sockstat{
+		TCP:  proc.SockstatProtocol{InUse: 41, TW: 318, Mem: 9},
+		FRAG: proc.SockstatProtocol{InUse: 1, Memory: 4096},
+	}
+	hd, rows := ConvertSockstat(st)
+	if rows[0][0] != "TCP" || rows[0][1] != "41" || rows[0][3] != "318" || rows[0][5] != "9" {
+		t.Fatalf("unexpected row %v", rows[0])
+	}
+	if rows[4][0] != "FRAG" || rows[4][5] != "" || rows[4][6] != "4096" {
+		t.Fatalf("unexpected row %v", rows[4])
+	}
+	txt := StringSockstat(hd, rows, -1)
+	fmt.Println(txt)
+}
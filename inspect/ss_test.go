@@ -2,7 +2,13 @@ package inspect
 
 import (
 	"fmt"
+	"net"
+	"os"
 	"testing"
+
+	"github.com/gyuho/linux-inspect/sockdiag"
+
+	"golang.org/x/sys/unix"
 )
 
 func TestGetSS(t *testing.T) {
@@ -24,3 +30,125 @@ func TestGetSSWithFilter(t *testing.T) {
 	txt := StringSS(hd, rows, -1)
 	fmt.Println(txt)
 }
+
+func TestGetSSWithProtocols(t *testing.T) {
+	ss, err := GetSS(WithPID(int64(os.Getpid())), WithUDP(), WithUDP6(), WithRaw(), WithRaw6(), WithUnix())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range ss {
+		switch s.Protocol {
+		case "udp", "udp6", "raw", "raw6", "unix":
+		default:
+			t.Fatalf("unexpected protocol %q", s.Protocol)
+		}
+	}
+	hd, rows := ConvertSS(ss...)
+	txt := StringSS(hd, rows, -1)
+	fmt.Println(txt)
+}
+
+func TestGetSSOwner(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+
+	pid := int64(os.Getpid())
+	port := int64(ln.Addr().(*net.TCPAddr).Port)
+
+	ss, err := GetSS(WithPID(pid), WithTCP(), WithLocalPort(port))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ss) != 1 {
+		t.Fatalf("expected 1 entry, got %+v", ss)
+	}
+	if ss[0].PID != pid || ss[0].State != "LISTEN" || ss[0].Inode == "" {
+		t.Fatalf("unexpected entry %+v", ss[0])
+	}
+	inode := ss[0].Inode
+
+	// sockets of PID 1 must not be attributed to this process
+	ss, err = GetSS(WithPID(1), WithTCP(), WithLocalPort(port))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range ss {
+		if s.PID != 1 {
+			t.Fatalf("unexpected owner %+v", s)
+		}
+		if s.Inode == inode {
+			t.Fatalf("socket %s attributed to PID 1", s.Inode)
+		}
+	}
+}
+
+func TestGetSSNetlink(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		b := make([]byte, 5)
+		conn.Read(b)
+		conn.Write(b)
+		conn.Read(b) // wait for client to close
+	}()
+
+	conn, err := net.Dial("tcp", ln.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	if _, err = conn.Write([]byte("hello")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = conn.Read(make([]byte, 5)); err != nil {
+		t.Fatal(err)
+	}
+
+	pid := int64(os.Getpid())
+	port := int64(ln.Addr().(*net.TCPAddr).Port)
+	ss, err := GetSS(WithPID(pid), WithTCP(), WithRemotePort(port), WithNetlink())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ss) != 1 {
+		t.Fatalf("expected 1 entry, got %+v", ss)
+	}
+	if ss[0].PID != pid || ss[0].State != "ESTABLISHED" {
+		t.Fatalf("unexpected entry %+v", ss[0])
+	}
+	if _, err = sockdiag.GetTCP(unix.AF_INET); err != nil {
+		t.Skipf("NETLINK_SOCK_DIAG is not available (%v)", err)
+	}
+	if !ss[0].HasTCPInfo || ss[0].Cwnd == 0 || ss[0].RTTNum == 0 {
+		t.Fatalf("expected tcp_info, got %+v", ss[0])
+	}
+	hd, rows := ConvertSS(ss...)
+	txt := StringSS(hd, rows, -1)
+	fmt.Println(txt)
+}
+
+func TestNormalizeIP(t *testing.T) {
+	tests := []struct{ s, exp string }{
+		{"0000:0000:0000:0000:0000:0000:0000:0001", "::1"},
+		{"0000:0000:0000:0000:0000:FFFF:7F00:0001", "127.0.0.1"},
+		{"2601:0645:C100:B640:C536:110C:750C:EBC1", "2601:645:c100:b640:c536:110c:750c:ebc1"},
+		{"127.0.0.1", "127.0.0.1"},
+		{"", ""},
+	}
+	for i, tt := range tests {
+		if s := normalizeIP(tt.s); s != tt.exp {
+			t.Fatalf("#%d: expected %q, got %q", i, tt.exp, s)
+		}
+	}
+}
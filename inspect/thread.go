@@ -0,0 +1,222 @@
+package inspect
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/gyuho/linux-inspect/proc"
+
+	"github.com/gyuho/dataframe"
+	"github.com/olekukonko/tablewriter"
+)
+
+// ThreadEntry is a thread entry.
+// Simplied from 'Stat' and 'Status' in '/proc/$PID/task/$TID'.
+type ThreadEntry struct {
+	// Name is the thread name from 'comm'.
+	Name  string
+	State string
+	PID   int64
+	TID   int64
+
+	// Processor is the CPU number last executed on.
+	Processor int64
+
+	CPU        string
+	UserTime   string
+	SystemTime string
+
+	VoluntaryCtxtSwitches    uint64
+	NonvoluntaryCtxtSwitches uint64
+
+	// extra fields for sorting
+	CPUNum        float64
+	UserTimeNum   time.Duration
+	SystemTimeNum time.Duration
+}
+
+// GetThreads finds all threads of the PID, sorted by CPU usage
+// in descending order. CPU usage is sampled with 'WithCPUSampler',
+// or a new CPUSampler.
+func GetThreads(pid int64, opts ...OpFunc) ([]ThreadEntry, error) {
+	tids, err := proc.ListTIDs(pid)
+	if err != nil {
+		return nil, err
+	}
+	return getThreads(map[int64][]int64{pid: tids}, opts)
+}
+
+// GetThreadsByPIDs finds all threads of the PIDs, sorted by CPU usage
+// in descending order. CPU usage of all threads is sampled at once.
+// PIDs that have exited are skipped.
+func GetThreadsByPIDs(pids []int64, opts ...OpFunc) ([]ThreadEntry, error) {
+	pidToTIDs := make(map[int64][]int64, len(pids))
+	for _, pid := range pids {
+		tids, err := proc.ListTIDs(pid)
+		if err != nil {
+			if !os.IsNotExist(err) {
+				return nil, err
+			}
+			log.Printf("proc.ListTIDs error %v for PID %d (process may have exited)", err, pid)
+			continue
+		}
+		pidToTIDs[pid] = tids
+	}
+	return getThreads(pidToTIDs, opts)
+}
+
+func getThreads(pidToTIDs map[int64][]int64, opts []OpFunc) (ths []ThreadEntry, err error) {
+	op := &EntryOp{}
+	op.applyOpts(opts)
+
+	sampler := op.CPUSampler
+	if sampler == nil {
+		sampler = NewCPUSampler(DefaultCPUSampleInterval)
+	}
+	cpuM, err := sampler.SampleThreads(pidToTIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for pid, tids := range pidToTIDs {
+		for _, tid := range tids {
+			th, err := getThreadEntry(pid, tid, cpuM[tid])
+			if err != nil {
+				// thread may have exited
+				log.Printf("getThreadEntry error %v for PID %d, TID %d", err, pid, tid)
+				continue
+			}
+			ths = append(ths, th)
+		}
+	}
+	sort.Slice(ths, func(i, j int) bool {
+		if ths[i].CPUNum != ths[j].CPUNum {
+			return ths[i].CPUNum > ths[j].CPUNum
+		}
+		return ths[i].TID < ths[j].TID
+	})
+
+	if op.TopLimit > 0 && len(ths) > op.TopLimit {
+		ths = ths[:op.TopLimit:op.TopLimit]
+	}
+	return ths, nil
+}
+
+func getThreadEntry(pid, tid int64, cpuPercent float64) (ThreadEntry, error) {
+	stat, err := proc.GetStatByTID(pid, tid)
+	if err != nil {
+		return ThreadEntry{}, err
+	}
+	status, err := proc.GetStatusByTID(pid, tid)
+	if err != nil {
+		return ThreadEntry{}, err
+	}
+	name, err := proc.GetCommByTID(pid, tid)
+	if err != nil {
+		name = stat.Comm
+	}
+
+	utime := time.Duration(stat.Utime) * time.Second / time.Duration(clockTicks)
+	stime := time.Duration(stat.Stime) * time.Second / time.Duration(clockTicks)
+	return ThreadEntry{
+		Name:  name,
+		State: status.StateParsedStatus,
+		PID:   pid,
+		TID:   tid,
+
+		Processor: stat.Processor,
+
+		CPU:        fmt.Sprintf("%3.2f %%", cpuPercent),
+		UserTime:   utime.String(),
+		SystemTime: stime.String(),
+
+		VoluntaryCtxtSwitches:    status.VoluntaryCtxtSwitches,
+		NonvoluntaryCtxtSwitches: status.NonvoluntaryCtxtSwitches,
+
+		CPUNum:        cpuPercent,
+		UserTimeNum:   utime,
+		SystemTimeNum: stime,
+	}, nil
+}
+
+const columnsThreadToShow = 10
+
+var columnsThreadEntry = []string{
+	"NAME",
+
+	"STATE",
+	"PID",
+	"TID",
+	"PROCESSOR",
+
+	"CPU",
+	"USER-TIME",
+	"SYSTEM-TIME",
+
+	"VOLUNTARY-CTXT-SWITCHES",
+	"NON-VOLUNTARY-CTXT-SWITCHES",
+
+	// extra for sorting
+	"CPU-NUM",
+	"USER-TIME-NUM",
+	"SYSTEM-TIME-NUM",
+}
+
+// ConvertThreads converts to rows.
+func ConvertThreads(ths ...ThreadEntry) (header []string, rows [][]string) {
+	header = columnsThreadEntry
+	rows = make([][]string, len(ths))
+	for i, elem := range ths {
+		row := make([]string, len(columnsThreadEntry))
+		row[0] = elem.Name
+
+		row[1] = elem.State
+		row[2] = fmt.Sprintf("%d", elem.PID)
+		row[3] = fmt.Sprintf("%d", elem.TID)
+		row[4] = fmt.Sprintf("%d", elem.Processor)
+
+		row[5] = elem.CPU
+		row[6] = elem.UserTime
+		row[7] = elem.SystemTime
+
+		row[8] = fmt.Sprintf("%d", elem.VoluntaryCtxtSwitches)
+		row[9] = fmt.Sprintf("%d", elem.NonvoluntaryCtxtSwitches)
+
+		row[10] = fmt.Sprintf("%3.2f", elem.CPUNum)
+		row[11] = fmt.Sprintf("%d", elem.UserTimeNum)
+		row[12] = fmt.Sprintf("%d", elem.SystemTimeNum)
+
+		rows[i] = row
+	}
+	dataframe.SortBy(
+		rows,
+		dataframe.Float64DescendingFunc(10), // CPUNum
+		dataframe.Float64DescendingFunc(11), // UserTimeNum
+	).Sort(rows)
+
+	return
+}
+
+// StringThreads converts in print-friendly format.
+func StringThreads(header []string, rows [][]string, topLimit int) string {
+	buf := new(bytes.Buffer)
+	tw := tablewriter.NewWriter(buf)
+	tw.SetHeader(header[:columnsThreadToShow:columnsThreadToShow])
+
+	if topLimit > 0 && len(rows) > topLimit {
+		rows = rows[:topLimit:topLimit]
+	}
+
+	for _, row := range rows {
+		tw.Append(row[:columnsThreadToShow:columnsThreadToShow])
+	}
+	tw.SetAutoFormatHeaders(false)
+	tw.SetAlignment(tablewriter.ALIGN_RIGHT)
+	tw.Render()
+
+	return buf.String()
+}
@@ -0,0 +1,72 @@
+package inspect
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"testing"
+	"unsafe"
+
+	"github.com/gyuho/linux-inspect/proc"
+
+	"golang.org/x/sys/unix"
+)
+
+func TestGetThreads(t *testing.T) {
+	pid := int64(os.Getpid())
+
+	// name a busy thread to find it, and restore the name
+	// in case it is the thread group leader (process name)
+	readyc, donec, exitc := make(chan error), make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(exitc)
+		runtime.LockOSThread()
+		defer runtime.UnlockOSThread()
+
+		orig, err := proc.GetCommByTID(pid, int64(unix.Gettid()))
+		if err != nil {
+			readyc <- err
+			return
+		}
+		if err = setThreadName("hot-thread"); err != nil {
+			readyc <- err
+			return
+		}
+		defer setThreadName(orig)
+
+		readyc <- nil
+		for {
+			select {
+			case <-donec:
+				return
+			default:
+			}
+		}
+	}()
+	defer func() {
+		close(donec)
+		<-exitc
+	}()
+	if err := <-readyc; err != nil {
+		t.Skip(err)
+	}
+
+	ths, err := GetThreads(pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ths) < 2 {
+		t.Fatalf("expected multiple threads, got %+v", ths)
+	}
+	if ths[0].Name != "hot-thread" || ths[0].PID != pid || ths[0].CPUNum <= 0 {
+		t.Fatalf("expected busy 'hot-thread' first, got %+v", ths[0])
+	}
+	hd, rows := ConvertThreads(ths...)
+	txt := StringThreads(hd, rows, -1)
+	fmt.Println(txt)
+}
+
+func setThreadName(name string) error {
+	b := append([]byte(name), 0)
+	return unix.Prctl(unix.PR_SET_NAME, uintptr(unsafe.Pointer(&b[0])), 0, 0, 0)
+}
@@ -0,0 +1,79 @@
+package proc
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"github.com/gyuho/linux-inspect/pkg/fileutil"
+)
+
+// Cgroup is a cgroup membership in '/proc/$PID/cgroup'.
+// Reference http://man7.org/linux/man-pages/man7/cgroups.7.html.
+type Cgroup struct {
+	// HierarchyID is 0 for the cgroup v2 unified hierarchy.
+	HierarchyID int64
+	// Controllers are the controllers bound to the cgroup v1
+	// hierarchy (e.g. 'cpu', 'cpuacct', 'name=systemd').
+	// Empty for cgroup v2.
+	Controllers []string
+	// Path is the cgroup path relative to the mount point
+	// of the hierarchy (e.g. '/system.slice/docker-$ID.scope').
+	Path string
+}
+
+// IsV2 returns true if the cgroup is in the cgroup v2 unified hierarchy.
+func (c Cgroup) IsV2() bool {
+	return c.HierarchyID == 0 && len(c.Controllers) == 0
+}
+
+// GetCgroup reads '/proc/$PID/cgroup', which lists cgroup v1
+// hierarchies and the cgroup v2 unified hierarchy ('0::$PATH').
+func GetCgroup(pid int64) ([]Cgroup, error) {
+	return defaultFS.GetCgroup(pid)
+}
+
+// GetCgroup reads '$ROOT/$PID/cgroup'.
+func (fs FS) GetCgroup(pid int64) ([]Cgroup, error) {
+	f, err := fileutil.OpenToRead(fs.pidPath(pid, "cgroup"))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	d, err := ioutil.ReadAll(f)
+	if err != nil {
+		return nil, err
+	}
+	return parseCgroup(d)
+}
+
+// parseCgroup parses 'hierarchy-ID:controller-list:cgroup-path'.
+func parseCgroup(d []byte) ([]Cgroup, error) {
+	var cgs []Cgroup
+	scanner := bufio.NewScanner(bytes.NewReader(d))
+	for scanner.Scan() {
+		txt := strings.TrimSpace(scanner.Text())
+		if txt == "" {
+			continue
+		}
+		// cgroup path may contain ':'
+		fs := strings.SplitN(txt, ":", 3)
+		if len(fs) != 3 {
+			return nil, fmt.Errorf("not enough fields at %q", txt)
+		}
+		id, err := strconv.ParseInt(fs[0], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		cg := Cgroup{HierarchyID: id, Path: fs[2]}
+		if fs[1] != "" {
+			cg.Controllers = strings.Split(fs[1], ",")
+		}
+		cgs = append(cgs, cg)
+	}
+	return cgs, scanner.Err()
+}
@@ -0,0 +1,44 @@
+package proc
+
+import (
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestParseCgroup(t *testing.T) {
+	cgs, err := parseCgroup([]byte(`12:pids:/docker/4a3b2c
+11:cpu,cpuacct:/docker/4a3b2c
+1:name=systemd:/docker/4a3b2c
+0::/system.slice/containerd.service
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []Cgroup{
+		{HierarchyID: 12, Controllers: []string{"pids"}, Path: "/docker/4a3b2c"},
+		{HierarchyID: 11, Controllers: []string{"cpu", "cpuacct"}, Path: "/docker/4a3b2c"},
+		{HierarchyID: 1, Controllers: []string{"name=systemd"}, Path: "/docker/4a3b2c"},
+		{HierarchyID: 0, Path: "/system.slice/containerd.service"},
+	}
+	if !reflect.DeepEqual(cgs, expected) {
+		t.Fatalf("expected %+v, got %+v", expected, cgs)
+	}
+	if cgs[0].IsV2() || !cgs[3].IsV2() {
+		t.Fatalf("unexpected IsV2 %+v", cgs)
+	}
+
+	if _, err = parseCgroup([]byte("0:/\n")); err == nil {
+		t.Fatal("expected error for not enough fields")
+	}
+}
+
+func TestGetCgroup(t *testing.T) {
+	cgs, err := GetCgroup(int64(os.Getpid()))
+	if err != nil {
+		t.Skip(err)
+	}
+	if len(cgs) == 0 {
+		t.Fatal("expected cgroups")
+	}
+}
@@ -0,0 +1,64 @@
+package proc
+
+import (
+	"bytes"
+	"io/ioutil"
+
+	"github.com/gyuho/linux-inspect/pkg/fileutil"
+)
+
+// GetCmdline reads '/proc/$PID/cmdline', the full command line
+// arguments. It is empty for kernel threads and zombie processes.
+func GetCmdline(pid int64) ([]string, error) {
+	return defaultFS.GetCmdline(pid)
+}
+
+// GetCmdline reads '$ROOT/$PID/cmdline'.
+func (fs FS) GetCmdline(pid int64) ([]string, error) {
+	d, err := readNullSeparated(fs.pidPath(pid, "cmdline"))
+	if err != nil {
+		return nil, err
+	}
+	return parseNullSeparated(d), nil
+}
+
+// GetEnviron reads '/proc/$PID/environ', the initial environment
+// variables in 'KEY=VALUE' format when the process was started.
+// Reading other users' environment needs root permission.
+func GetEnviron(pid int64) ([]string, error) {
+	return defaultFS.GetEnviron(pid)
+}
+
+// GetEnviron reads '$ROOT/$PID/environ'.
+func (fs FS) GetEnviron(pid int64) ([]string, error) {
+	d, err := readNullSeparated(fs.pidPath(pid, "environ"))
+	if err != nil {
+		return nil, err
+	}
+	return parseNullSeparated(d), nil
+}
+
+func readNullSeparated(fpath string) ([]byte, error) {
+	f, err := fileutil.OpenToRead(fpath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ioutil.ReadAll(f)
+}
+
+// parseNullSeparated splits 'a\x00b\x00' into ['a', 'b'].
+// Processes may overwrite the command line with spaces
+// (e.g. 'nginx: worker process'), which is kept as one argument.
+func parseNullSeparated(d []byte) []string {
+	d = bytes.TrimRight(d, "\x00")
+	if len(d) == 0 {
+		return nil
+	}
+	fs := bytes.Split(d, []byte{0})
+	ss := make([]string, len(fs))
+	for i, f := range fs {
+		ss[i] = string(f)
+	}
+	return ss
+}
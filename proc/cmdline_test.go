@@ -0,0 +1,52 @@
+package proc
+
+import (
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseNullSeparated(t *testing.T) {
+	tests := []struct {
+		d   string
+		exp []string
+	}{
+		{"", nil},
+		{"\x00", nil},
+		{"/usr/bin/java\x00-Xmx1g\x00-jar\x00app.jar\x00", []string{"/usr/bin/java", "-Xmx1g", "-jar", "app.jar"}},
+		{"nginx: worker process\x00", []string{"nginx: worker process"}},
+		{"a\x00\x00b\x00", []string{"a", "", "b"}},
+	}
+	for i, tt := range tests {
+		if ss := parseNullSeparated([]byte(tt.d)); !reflect.DeepEqual(ss, tt.exp) {
+			t.Fatalf("#%d: expected %q, got %q", i, tt.exp, ss)
+		}
+	}
+}
+
+func TestGetCmdline(t *testing.T) {
+	args, err := GetCmdline(int64(os.Getpid()))
+	if err != nil {
+		t.Skip(err)
+	}
+	if !reflect.DeepEqual(args, os.Args) {
+		t.Fatalf("expected %q, got %q", os.Args, args)
+	}
+}
+
+func TestGetEnviron(t *testing.T) {
+	os.Setenv("PROC_ENVIRON_TEST", "set after start")
+	envs, err := GetEnviron(int64(os.Getpid()))
+	if err != nil {
+		t.Skip(err)
+	}
+	for _, env := range envs {
+		if !strings.Contains(env, "=") {
+			t.Fatalf("unexpected environment variable %q", env)
+		}
+		if strings.HasPrefix(env, "PROC_ENVIRON_TEST=") {
+			t.Fatalf("expected only initial environment, got %q", env)
+		}
+	}
+}
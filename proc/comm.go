@@ -0,0 +1,43 @@
+package proc
+
+import (
+	"io/ioutil"
+	"strings"
+
+	"github.com/gyuho/linux-inspect/pkg/fileutil"
+)
+
+// GetCommByPID reads '/proc/$PID/comm', the command name.
+func GetCommByPID(pid int64) (string, error) {
+	return defaultFS.GetCommByPID(pid)
+}
+
+// GetCommByPID reads '$ROOT/$PID/comm'.
+func (fs FS) GetCommByPID(pid int64) (string, error) {
+	return getComm(fs.pidPath(pid, "comm"))
+}
+
+// GetCommByTID reads '/proc/$PID/task/$TID/comm', the thread name
+// which can be changed with 'prctl(PR_SET_NAME)'.
+func GetCommByTID(pid, tid int64) (string, error) {
+	return defaultFS.GetCommByTID(pid, tid)
+}
+
+// GetCommByTID reads '$ROOT/$PID/task/$TID/comm'.
+func (fs FS) GetCommByTID(pid, tid int64) (string, error) {
+	return getComm(fs.taskPath(pid, tid, "comm"))
+}
+
+func getComm(fpath string) (string, error) {
+	f, err := fileutil.OpenToRead(fpath)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	d, err := ioutil.ReadAll(f)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(string(d), "\n"), nil
+}
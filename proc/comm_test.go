@@ -0,0 +1,30 @@
+package proc
+
+import (
+	"fmt"
+	"os"
+	"testing"
+)
+
+func TestGetCommByTID(t *testing.T) {
+	pid := int64(os.Getpid())
+	tids, err := ListTIDs(pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tids) == 0 {
+		t.Fatalf("no thread found for PID %d", pid)
+	}
+	comm, err := GetCommByPID(pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leader, err := GetCommByTID(pid, pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if comm == "" || comm != leader {
+		t.Fatalf("thread group leader comm expected %q, got %q", comm, leader)
+	}
+	fmt.Println("GetCommByTID:", tids, comm)
+}
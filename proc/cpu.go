@@ -0,0 +1,83 @@
+package proc
+
+import (
+	"encoding/binary"
+	"io/ioutil"
+	"unsafe"
+)
+
+// DefaultClockTicks is the USER_HZ value ('_SC_CLK_TCK') that
+// Linux exposes to user space on most architectures.
+const DefaultClockTicks = 100
+
+// atClockTicks is 'AT_CLKTCK' auxiliary vector entry type.
+// Reference http://man7.org/linux/man-pages/man3/getauxval.3.html.
+const atClockTicks = 17
+
+// GetClockTicks returns the number of clock ticks per second ('_SC_CLK_TCK'),
+// which is the unit of 'utime' and 'stime' in '/proc/$PID/stat'.
+// It reads 'AT_CLKTCK' from '/proc/self/auxv' without cgo,
+// and falls back to 'DefaultClockTicks'.
+func GetClockTicks() int64 {
+	b, err := ioutil.ReadFile("/proc/self/auxv")
+	if err != nil {
+		return DefaultClockTicks
+	}
+	v, ok := parseAuxv(b, atClockTicks)
+	if !ok || v == 0 {
+		return DefaultClockTicks
+	}
+	return int64(v)
+}
+
+// parseAuxv finds the value of the given auxiliary vector type.
+// Each entry is a pair of native words (type, value).
+func parseAuxv(b []byte, tp uint64) (uint64, bool) {
+	wordSize := int(unsafe.Sizeof(uintptr(0)))
+	for i := 0; i+2*wordSize <= len(b); i += 2 * wordSize {
+		var k, v uint64
+		if wordSize == 8 {
+			k = nativeEndian.Uint64(b[i : i+8])
+			v = nativeEndian.Uint64(b[i+8 : i+16])
+		} else {
+			k = uint64(nativeEndian.Uint32(b[i : i+4]))
+			v = uint64(nativeEndian.Uint32(b[i+4 : i+8]))
+		}
+		if k == 0 { // AT_NULL
+			break
+		}
+		if k == tp {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+var nativeEndian binary.ByteOrder = func() binary.ByteOrder {
+	x := uint16(1)
+	if *(*byte)(unsafe.Pointer(&x)) == 1 {
+		return binary.LittleEndian
+	}
+	return binary.BigEndian
+}()
+
+// GetTotalJiffies reads the aggregate 'cpu' line in '/proc/stat'.
+// It returns the total number of clock ticks spent by all CPUs
+// (user, nice, system, idle, iowait, irq, softirq, steal)
+// and the number of CPUs.
+func GetTotalJiffies() (total uint64, cpus int, err error) {
+	return defaultFS.GetTotalJiffies()
+}
+
+// GetTotalJiffies reads the aggregate 'cpu' line in '$ROOT/stat'.
+func (fs FS) GetTotalJiffies() (total uint64, cpus int, err error) {
+	ss, err := fs.GetSystemStat()
+	if err != nil {
+		return 0, 0, err
+	}
+	cpus = len(ss.CPUs)
+	if cpus == 0 {
+		cpus = 1
+	}
+	return ss.CPU.Total(), cpus, nil
+}
@@ -0,0 +1,25 @@
+package proc
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestGetClockTicks(t *testing.T) {
+	tck := GetClockTicks()
+	if tck <= 0 {
+		t.Fatalf("unexpected clock ticks %d", tck)
+	}
+	fmt.Println("GetClockTicks:", tck)
+}
+
+func TestGetTotalJiffies(t *testing.T) {
+	total, cpus, err := GetTotalJiffies()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total == 0 || cpus == 0 {
+		t.Fatalf("unexpected total %d, cpus %d", total, cpus)
+	}
+	fmt.Println("GetTotalJiffies:", total, cpus)
+}
@@ -34,7 +34,12 @@ const (
 
 // GetDiskstats reads '/proc/diskstats'.
 func GetDiskstats() ([]DiskStat, error) {
-	f, err := fileutil.OpenToRead("/proc/diskstats")
+	return defaultFS.GetDiskstats()
+}
+
+// GetDiskstats reads '$ROOT/diskstats'.
+func (fs FS) GetDiskstats() ([]DiskStat, error) {
+	f, err := fileutil.OpenToRead(fs.path("diskstats"))
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,36 @@
+package proc
+
+import "os"
+
+// GetExe reads '/proc/$PID/exe', the path of the executable.
+// It ends with ' (deleted)' if the executable was removed or
+// replaced. Reading other users' processes needs root permission.
+func GetExe(pid int64) (string, error) {
+	return defaultFS.GetExe(pid)
+}
+
+// GetExe reads '$ROOT/$PID/exe'.
+func (fs FS) GetExe(pid int64) (string, error) {
+	return os.Readlink(fs.pidPath(pid, "exe"))
+}
+
+// GetCwd reads '/proc/$PID/cwd', the current working directory.
+func GetCwd(pid int64) (string, error) {
+	return defaultFS.GetCwd(pid)
+}
+
+// GetCwd reads '$ROOT/$PID/cwd'.
+func (fs FS) GetCwd(pid int64) (string, error) {
+	return os.Readlink(fs.pidPath(pid, "cwd"))
+}
+
+// GetRoot reads '/proc/$PID/root', the root directory
+// of the process (e.g. changed with 'chroot').
+func GetRoot(pid int64) (string, error) {
+	return defaultFS.GetRoot(pid)
+}
+
+// GetRoot reads '$ROOT/$PID/root'.
+func (fs FS) GetRoot(pid int64) (string, error) {
+	return os.Readlink(fs.pidPath(pid, "root"))
+}
@@ -0,0 +1,47 @@
+package proc
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetExe(t *testing.T) {
+	pid := int64(os.Getpid())
+
+	exe, err := GetExe(pid)
+	if err != nil {
+		t.Skip(err)
+	}
+	expected, err := os.Executable()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exe != expected {
+		t.Fatalf("exe expected %q, got %q", expected, exe)
+	}
+
+	cwd, err := GetCwd(pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 'Getwd' may return a symlink path from '$PWD'
+	if wd, err = filepath.EvalSymlinks(wd); err != nil {
+		t.Fatal(err)
+	}
+	if cwd != wd {
+		t.Fatalf("cwd expected %q, got %q", wd, cwd)
+	}
+
+	root, err := GetRoot(pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != "/" {
+		t.Fatalf("root expected '/', got %q", root)
+	}
+}
@@ -0,0 +1,158 @@
+package proc
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gyuho/linux-inspect/pkg/fileutil"
+)
+
+// FDInfo is '/proc/$PID/fdinfo/$FD' in Linux.
+type FDInfo struct {
+	// Pos is the current file offset.
+	Pos uint64
+	// Flags is the file access mode and status flags
+	// (e.g. 'O_RDWR|O_APPEND'), which are octal in 'fdinfo'.
+	Flags uint64
+	// MntID is the ID of the mount containing the file.
+	MntID int64
+	// Ino is the inode number of the file, 0 in older kernels.
+	Ino uint64
+}
+
+// FD is an open file descriptor in '/proc/$PID/fd'.
+type FD struct {
+	FD int64
+	// Target is the symlink target, such as '/var/log/syslog',
+	// 'socket:[12345]', 'pipe:[12345]', 'anon_inode:[eventfd]'.
+	// Deleted files end with ' (deleted)'.
+	Target string
+
+	// Mode and Size are of the open file, which are still
+	// available after the file is deleted.
+	Mode os.FileMode
+	Size int64
+
+	FDInfo
+}
+
+// GetFDs reads '/proc/$PID/fd' and '/proc/$PID/fdinfo', sorted
+// by file descriptor. Descriptors that are closed while reading
+// are skipped, and other errors are returned. Reading other users'
+// file descriptors needs root.
+func GetFDs(pid int64) ([]FD, error) {
+	return defaultFS.GetFDs(pid)
+}
+
+// GetFDs reads '$ROOT/$PID/fd' and '$ROOT/$PID/fdinfo'.
+func (fs FS) GetFDs(pid int64) ([]FD, error) {
+	ds, err := ioutil.ReadDir(fs.pidPath(pid, "fd"))
+	if err != nil {
+		return nil, err
+	}
+
+	fds := make([]FD, 0, len(ds))
+	for _, f := range ds {
+		fd, err := strconv.ParseInt(f.Name(), 10, 64)
+		if err != nil {
+			continue
+		}
+		fpath := fs.pidPath(pid, "fd", f.Name())
+		target, err := os.Readlink(fpath)
+		if os.IsNotExist(err) {
+			// file descriptor may have been closed
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		info, err := fs.GetFDInfo(pid, fd)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		ent := FD{FD: fd, Target: target, FDInfo: info}
+		if st, err := os.Stat(fpath); err == nil {
+			ent.Mode, ent.Size = st.Mode(), st.Size()
+		}
+		fds = append(fds, ent)
+	}
+	sort.Slice(fds, func(i, j int) bool { return fds[i].FD < fds[j].FD })
+	return fds, nil
+}
+
+// CountFDs returns the number of open file descriptors in
+// '/proc/$PID/fd', without reading each descriptor.
+func CountFDs(pid int64) (uint64, error) {
+	return defaultFS.CountFDs(pid)
+}
+
+// CountFDs returns the number of open file descriptors in '$ROOT/$PID/fd'.
+func (fs FS) CountFDs(pid int64) (uint64, error) {
+	f, err := os.Open(fs.pidPath(pid, "fd"))
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	names, err := f.Readdirnames(-1)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(len(names)), nil
+}
+
+// GetFDInfo reads '/proc/$PID/fdinfo/$FD'.
+func GetFDInfo(pid, fd int64) (FDInfo, error) {
+	return defaultFS.GetFDInfo(pid, fd)
+}
+
+// GetFDInfo reads '$ROOT/$PID/fdinfo/$FD'.
+func (fs FS) GetFDInfo(pid, fd int64) (FDInfo, error) {
+	f, err := fileutil.OpenToRead(fs.pidPath(pid, "fdinfo", fmt.Sprintf("%d", fd)))
+	if err != nil {
+		return FDInfo{}, err
+	}
+	defer f.Close()
+
+	d, err := ioutil.ReadAll(f)
+	if err != nil {
+		return FDInfo{}, err
+	}
+	return parseFDInfo(d)
+}
+
+// parseFDInfo parses the common fields. Other fields that depend
+// on the file type (e.g. 'eventfd-count', 'tfd') are ignored.
+func parseFDInfo(d []byte) (info FDInfo, err error) {
+	scanner := bufio.NewScanner(bytes.NewReader(d))
+	for scanner.Scan() {
+		fs := strings.SplitN(scanner.Text(), ":", 2)
+		if len(fs) != 2 {
+			continue
+		}
+		v := strings.TrimSpace(fs[1])
+		switch fs[0] {
+		case "pos":
+			info.Pos, err = strconv.ParseUint(v, 10, 64)
+		case "flags":
+			info.Flags, err = strconv.ParseUint(v, 8, 64)
+		case "mnt_id":
+			info.MntID, err = strconv.ParseInt(v, 10, 64)
+		case "ino":
+			info.Ino, err = strconv.ParseUint(v, 10, 64)
+		}
+		if err != nil {
+			return FDInfo{}, fmt.Errorf("%v at %q", err, scanner.Text())
+		}
+	}
+	return info, scanner.Err()
+}
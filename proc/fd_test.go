@@ -0,0 +1,81 @@
+package proc
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestParseFDInfo(t *testing.T) {
+	info, err := parseFDInfo([]byte("pos:\t4096\nflags:\t02100002\nmnt_id:\t25\nino:\t42735\neventfd-count:\t0\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := FDInfo{Pos: 4096, Flags: 02100002, MntID: 25, Ino: 42735}
+	if info != expected {
+		t.Fatalf("expected %+v, got %+v", expected, info)
+	}
+
+	if _, err = parseFDInfo([]byte("pos:\tx\n")); err == nil {
+		t.Fatal("expected error for invalid pos")
+	}
+}
+
+func TestGetFDs(t *testing.T) {
+	f, err := ioutil.TempFile(os.TempDir(), "proc-fd-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+	if _, err = f.WriteString("hello"); err != nil {
+		t.Fatal(err)
+	}
+
+	fds, err := GetFDs(int64(os.Getpid()))
+	if err != nil {
+		t.Skip(err)
+	}
+	found := false
+	for _, fd := range fds {
+		if fd.FD != int64(f.Fd()) {
+			continue
+		}
+		found = true
+		if fd.Target != f.Name() {
+			t.Fatalf("target expected %q, got %q", f.Name(), fd.Target)
+		}
+		if !fd.Mode.IsRegular() || fd.Size != 5 {
+			t.Fatalf("unexpected mode %v, size %d", fd.Mode, fd.Size)
+		}
+		if fd.Pos != 5 {
+			t.Fatalf("pos expected 5, got %d", fd.Pos)
+		}
+		if fd.Flags&03 != uint64(os.O_RDWR) {
+			t.Fatalf("flags expected O_RDWR, got %o", fd.Flags)
+		}
+	}
+	if !found {
+		t.Fatalf("fd %d was not found in %+v", f.Fd(), fds)
+	}
+
+	n, err := CountFDs(int64(os.Getpid()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n < uint64(len(fds)) {
+		t.Fatalf("CountFDs expected at least %d, got %d", len(fds), n)
+	}
+
+	os.Remove(f.Name())
+	fds, err = GetFDs(int64(os.Getpid()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, fd := range fds {
+		if fd.FD == int64(f.Fd()) && !strings.HasSuffix(fd.Target, " (deleted)") {
+			t.Fatalf("target expected deleted, got %q", fd.Target)
+		}
+	}
+}
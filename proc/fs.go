@@ -0,0 +1,51 @@
+package proc
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// DefaultRoot is the default procfs mount point.
+const DefaultRoot = "/proc"
+
+// FS represents a procfs tree mounted at a root path.
+// Use this to inspect containers or chroots whose procfs
+// is bind-mounted elsewhere, or to read captured fixture trees.
+type FS struct {
+	root string
+}
+
+// defaultFS reads from '/proc'; package-level readers wrap this.
+var defaultFS = FS{root: DefaultRoot}
+
+// NewFS returns a new FS rooted at the given path.
+func NewFS(root string) (FS, error) {
+	if root == "" {
+		root = DefaultRoot
+	}
+	if _, err := os.Stat(root); err != nil {
+		return FS{}, err
+	}
+	return FS{root: filepath.Clean(root)}, nil
+}
+
+// Root returns the procfs root path.
+func (fs FS) Root() string {
+	return fs.root
+}
+
+// path joins the path elements under the procfs root.
+func (fs FS) path(elem ...string) string {
+	return filepath.Join(append([]string{fs.root}, elem...)...)
+}
+
+// pidPath returns '$ROOT/$PID/$ELEM'.
+func (fs FS) pidPath(pid int64, elem ...string) string {
+	return fs.path(append([]string{fmt.Sprintf("%d", pid)}, elem...)...)
+}
+
+// taskPath returns '$ROOT/$PID/task/$TID/$ELEM'.
+func (fs FS) taskPath(pid, tid int64, elem ...string) string {
+	return fs.pidPath(pid, append([]string{"task", fmt.Sprintf("%d", tid)}, elem...)...)
+}
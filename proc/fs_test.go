@@ -0,0 +1,183 @@
+package proc
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFS(t *testing.T) {
+	root, err := ioutil.TempDir(os.TempDir(), "proc-fs-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	files := map[string]string{
+		"loadavg":           "0.37 0.47 0.39 1/839 31397\n",
+		"uptime":            "1053.48 966.55\n",
+		"42/stat":           "42 (bash) S 1 42 42 34816 42 4194560 1044 2311 0 0 1 2 3 4 20 0 1 0 4302 24027136 1319 18446744073709551615 4194304 5173404 140737488346432 0 0 0 65536 3670020 1266777851 1 0 0 17 0 0 0 0 0 0 7273968 7310504 30306304 140737488348968 140737488348973 140737488348973 140737488351214 0\n",
+		"42/fd/3":           "",
+		"net/dev":           "Inter-|   Receive                                                |  Transmit\n face |bytes    packets errs drop fifo frame compressed multicast|bytes    packets errs drop fifo colls carrier compressed\n    lo:  1000      10    0    0    0     0          0         0     2000      20    0    0    0     0       0          0\n",
+		"diskstats":         "   8       0 sda 100 0 800 10 200 0 1600 20 0 30 30\n",
+		"42/io":             "rchar: 100\nwchar: 200\nsyscr: 1\nsyscw: 2\nread_bytes: 4096\nwrite_bytes: 8192\ncancelled_write_bytes: 0\n",
+		"42/status":         "Name:\tbash\nState:\tS (sleeping)\nPid:\t42\nPPid:\t1\nVmRSS:\t5276 kB\nThreads:\t1\n",
+		"42/smaps":          testSmaps,
+		"42/task/43/stat":   "43 (worker 1) R 1 42 42 34816 42 4194560 10 0 0 0 7 8 0 0 20 0 1 0 4310 24027136 1319 18446744073709551615 4194304 5173404 140737488346432 0 0 0 65536 3670020 1266777851 1 0 0 17 2 0 0 0 0 0 7273968 7310504 30306304 140737488348968 140737488348973 140737488348973 140737488351214 0\n",
+		"42/task/43/status": "Name:\tworker 1\nState:\tR (running)\nPid:\t43\nPPid:\t1\nvoluntary_ctxt_switches:\t5\n",
+		"42/task/43/io":     "rchar: 10\nwchar: 20\nsyscr: 1\nsyscw: 2\nread_bytes: 0\nwrite_bytes: 4096\ncancelled_write_bytes: 0\n",
+		"42/task/43/comm":   "worker 1\n",
+		"42/net/tcp":        "  sl  local_address rem_address   st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode\n   0: 0100007F:0050 00000000:0000 0A 00000000:00000000 00:00000000 00000000     0        0 12345 1 0000000000000000 100 0 0 10 0\n",
+	}
+	for name, txt := range files {
+		fpath := filepath.Join(root, name)
+		if err = os.MkdirAll(filepath.Dir(fpath), 0777); err != nil {
+			t.Fatal(err)
+		}
+		if err = ioutil.WriteFile(fpath, []byte(txt), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	fs, err := NewFS(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pids, err := fs.ListPIDs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pids) != 1 || pids[0] != 42 {
+		t.Fatalf("pids expected [42], got %v", pids)
+	}
+
+	fds, err := fs.ListFds()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fds) != 1 {
+		t.Fatalf("fds expected 1, got %v", fds)
+	}
+	pid, err := pidFromFd(fds[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pid != 42 {
+		t.Fatalf("pid expected 42, got %d", pid)
+	}
+
+	lv, err := fs.GetLoadAvg()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lv.Pid != 31397 {
+		t.Fatalf("load average pid expected 31397, got %d", lv.Pid)
+	}
+
+	u, err := fs.GetUptime()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.UptimeTotal != 1053.48 {
+		t.Fatalf("uptime expected 1053.48, got %f", u.UptimeTotal)
+	}
+
+	st, err := fs.GetStatByPID(42)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st.Comm != "bash" || st.Ppid != 1 {
+		t.Fatalf("unexpected stat %+v", st)
+	}
+
+	ss, err := fs.GetStatusByPID(42)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ss.Name != "bash" || ss.VmRSSBytesN != 5276*1024 {
+		t.Fatalf("unexpected status %+v", ss)
+	}
+
+	is, err := fs.GetIOByPID(42)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if is.WriteBytesBytesN != 8192 {
+		t.Fatalf("write bytes expected 8192, got %d", is.WriteBytesBytesN)
+	}
+
+	// no 'smaps_rollup', sums up 'smaps'
+	sr, err := fs.GetSmapsRollup(42)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sr.PssBytesN != (150+2048+16)*1024 {
+		t.Fatalf("Pss expected %d, got %d", (150+2048+16)*1024, sr.PssBytesN)
+	}
+
+	tids, err := fs.ListTIDs(42)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tids) != 1 || tids[0] != 43 {
+		t.Fatalf("tids expected [43], got %v", tids)
+	}
+	tst, err := fs.GetStatByTID(42, 43)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tst.Comm != "worker 1" || tst.Utime != 7 || tst.Processor != 2 {
+		t.Fatalf("unexpected task stat %+v", tst)
+	}
+	tss, err := fs.GetStatusByTID(42, 43)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tss.Pid != 43 || tss.VoluntaryCtxtSwitches != 5 {
+		t.Fatalf("unexpected task status %+v", tss)
+	}
+	tis, err := fs.GetIOByTID(42, 43)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tis.WriteBytesBytesN != 4096 {
+		t.Fatalf("task write bytes expected 4096, got %d", tis.WriteBytesBytesN)
+	}
+	comm, err := fs.GetCommByTID(42, 43)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if comm != "worker 1" {
+		t.Fatalf("task comm expected 'worker 1', got %q", comm)
+	}
+
+	nds, err := fs.GetNetDev()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(nds) != 1 || nds[0].Interface != "lo" || nds[0].TransmitBytes != 2000 {
+		t.Fatalf("unexpected net dev %+v", nds)
+	}
+
+	dss, err := fs.GetDiskstats()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dss) != 1 || dss[0].DeviceName != "sda" || dss[0].SectorsWritten != 1600 {
+		t.Fatalf("unexpected diskstats %+v", dss)
+	}
+
+	nts, err := fs.GetNetTCPByPID(42, TypeTCP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(nts) != 1 || nts[0].LocalAddressParsedIPPort != 80 || nts[0].StParsedStatus != "LISTEN" {
+		t.Fatalf("unexpected net tcp %+v", nts)
+	}
+
+	if _, err = NewFS(filepath.Join(root, "does-not-exist")); err == nil {
+		t.Fatal("expected error for missing root")
+	}
+}
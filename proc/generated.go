@@ -1,6 +1,6 @@
 package proc
 
-// updated at 2017-12-21 12:15:57.243686 -0800 PST
+// updated at 2026-10-17 13:43:18.19174233 -0700 PDT
 
 // NetDev is '/proc/net/dev' in Linux.
 // The dev pseudo-file contains network device status information.
@@ -45,6 +45,7 @@ type NetDev struct {
 
 // NetTCP is '/proc/net/tcp', '/proc/net/tcp6' in Linux.
 // Holds a dump of the TCP socket table.
+// Also used for '/proc/net/udp(6)', '/proc/net/raw(6)'.
 type NetTCP struct {
 	Type string `column:"type"`
 	// Sl is kernel hash slot.
@@ -78,6 +79,29 @@ type NetTCP struct {
 	Inode string `column:"inode"`
 }
 
+// NetUnix is '/proc/net/unix' in Linux.
+// Holds a dump of the UNIX domain sockets.
+type NetUnix struct {
+	// Num is kernel address of the socket.
+	Num string `column:"num"`
+	// RefCount is number of users of the socket.
+	RefCount string `column:"ref_count"`
+	// Protocol is currently always 0.
+	Protocol string `column:"protocol"`
+	// Flags is internal kernel flags holding the status of the socket.
+	Flags string `column:"flags"`
+	// Type is socket type (0001 for SOCK_STREAM, 0002 for SOCK_DGRAM, 0005 for SOCK_SEQPACKET).
+	Type             string `column:"type"`
+	TypeParsedStatus string `column:"type_parsed_status"`
+	// St is internal state of the socket.
+	St             string `column:"st"`
+	StParsedStatus string `column:"st_parsed_status"`
+	// Inode is inode raw data.
+	Inode string `column:"inode"`
+	// Path is bound path name (if any) of the socket ('@' prefix for abstract sockets).
+	Path string `column:"path"`
+}
+
 // LoadAvg is '/proc/loadavg' in Linux.
 type LoadAvg struct {
 	// LoadAvg1Minute is total uptime in seconds.
@@ -140,6 +164,648 @@ type DiskStat struct {
 	WeightedTimeSpentOnIOsMsParsedTime string `column:"weighted_time_spent_on_ios_ms_parsed_time"`
 }
 
+// CPUStat is a 'cpu' line in '/proc/stat' in Linux.
+type CPUStat struct {
+	// Name is CPU name ('cpu' for the aggregate of all CPUs, 'cpu0', 'cpu1', ... for each CPU).
+	Name string `column:"name"`
+	// User is time spent in user mode.
+	User uint64 `column:"user"`
+	// Nice is time spent in user mode with low priority (nice).
+	Nice uint64 `column:"nice"`
+	// System is time spent in system mode.
+	System uint64 `column:"system"`
+	// Idle is time spent in the idle task.
+	Idle uint64 `column:"idle"`
+	// Iowait is time waiting for I/O to complete (not reliable).
+	Iowait uint64 `column:"iowait"`
+	// Irq is time servicing interrupts.
+	Irq uint64 `column:"irq"`
+	// Softirq is time servicing softirqs.
+	Softirq uint64 `column:"softirq"`
+	// Steal is stolen time, which is the time spent in other operating systems when running in a virtualized environment.
+	Steal uint64 `column:"steal"`
+	// Guest is time spent running a virtual CPU for guest operating systems (accounted in user).
+	Guest uint64 `column:"guest"`
+	// GuestNice is time spent running a niced guest (accounted in nice).
+	GuestNice uint64 `column:"guest_nice"`
+}
+
+// SystemStat is '/proc/stat' in Linux.
+// It contains kernel/system statistics.
+type SystemStat struct {
+	// CPU is the aggregate of all CPUs.
+	CPU CPUStat `column:"cpu"`
+	// CPUs is the per-CPU statistics.
+	CPUs []CPUStat `column:"cpus"`
+	// Intr is total number of interrupts serviced since boot.
+	Intr uint64 `column:"intr"`
+	// Ctxt is number of context switches that the system underwent.
+	Ctxt uint64 `column:"ctxt"`
+	// Btime is boot time, in seconds since the Epoch, 1970-01-01 00:00:00 +0000 (UTC).
+	Btime uint64 `column:"btime"`
+	// Processes is number of forks since boot.
+	Processes uint64 `column:"processes"`
+	// ProcsRunning is number of processes in runnable state.
+	ProcsRunning uint64 `column:"procs_running"`
+	// ProcsBlocked is number of processes blocked waiting for I/O to complete.
+	ProcsBlocked uint64 `column:"procs_blocked"`
+	// Softirq is total number of softirqs serviced since boot.
+	Softirq uint64 `column:"softirq"`
+}
+
+// Meminfo is '/proc/meminfo' in Linux.
+// It reports statistics about memory usage on the system.
+type Meminfo struct {
+	// MemTotal is total usable RAM (i.e., physical RAM minus a few reserved bits and the kernel binary code).
+	MemTotal            string `yaml:"MemTotal"`
+	MemTotalBytesN      uint64 `yaml:"MemTotal_bytes_n"`
+	MemTotalParsedBytes string `yaml:"MemTotal_parsed_bytes"`
+	// MemFree is sum of LowFree and HighFree.
+	MemFree            string `yaml:"MemFree"`
+	MemFreeBytesN      uint64 `yaml:"MemFree_bytes_n"`
+	MemFreeParsedBytes string `yaml:"MemFree_parsed_bytes"`
+	// MemAvailable is estimate of how much memory is available for starting new applications, without swapping.
+	MemAvailable            string `yaml:"MemAvailable"`
+	MemAvailableBytesN      uint64 `yaml:"MemAvailable_bytes_n"`
+	MemAvailableParsedBytes string `yaml:"MemAvailable_parsed_bytes"`
+	// Buffers is relatively temporary storage for raw disk blocks.
+	Buffers            string `yaml:"Buffers"`
+	BuffersBytesN      uint64 `yaml:"Buffers_bytes_n"`
+	BuffersParsedBytes string `yaml:"Buffers_parsed_bytes"`
+	// Cached is in-memory cache for files read from the disk (the page cache), not including SwapCached.
+	Cached            string `yaml:"Cached"`
+	CachedBytesN      uint64 `yaml:"Cached_bytes_n"`
+	CachedParsedBytes string `yaml:"Cached_parsed_bytes"`
+	// SwapCached is memory that once was swapped out, is swapped back in but still also is in the swap file.
+	SwapCached            string `yaml:"SwapCached"`
+	SwapCachedBytesN      uint64 `yaml:"SwapCached_bytes_n"`
+	SwapCachedParsedBytes string `yaml:"SwapCached_parsed_bytes"`
+	// Active is memory that has been used more recently and usually not reclaimed unless absolutely necessary.
+	Active            string `yaml:"Active"`
+	ActiveBytesN      uint64 `yaml:"Active_bytes_n"`
+	ActiveParsedBytes string `yaml:"Active_parsed_bytes"`
+	// Inactive is memory which has been less recently used and is more eligible to be reclaimed for other purposes.
+	Inactive            string `yaml:"Inactive"`
+	InactiveBytesN      uint64 `yaml:"Inactive_bytes_n"`
+	InactiveParsedBytes string `yaml:"Inactive_parsed_bytes"`
+	// ActiveAnon is anonymous memory that has been used more recently.
+	ActiveAnon            string `yaml:"Active(anon)"`
+	ActiveAnonBytesN      uint64 `yaml:"Active(anon)_bytes_n"`
+	ActiveAnonParsedBytes string `yaml:"Active(anon)_parsed_bytes"`
+	// InactiveAnon is anonymous memory that has been less recently used and can be swapped out.
+	InactiveAnon            string `yaml:"Inactive(anon)"`
+	InactiveAnonBytesN      uint64 `yaml:"Inactive(anon)_bytes_n"`
+	InactiveAnonParsedBytes string `yaml:"Inactive(anon)_parsed_bytes"`
+	// ActiveFile is page cache memory that has been used more recently.
+	ActiveFile            string `yaml:"Active(file)"`
+	ActiveFileBytesN      uint64 `yaml:"Active(file)_bytes_n"`
+	ActiveFileParsedBytes string `yaml:"Active(file)_parsed_bytes"`
+	// InactiveFile is page cache memory that can be reclaimed without huge performance impact.
+	InactiveFile            string `yaml:"Inactive(file)"`
+	InactiveFileBytesN      uint64 `yaml:"Inactive(file)_bytes_n"`
+	InactiveFileParsedBytes string `yaml:"Inactive(file)_parsed_bytes"`
+	// Unevictable is memory that cannot be reclaimed (e.g. mlocked pages, ramfs).
+	Unevictable            string `yaml:"Unevictable"`
+	UnevictableBytesN      uint64 `yaml:"Unevictable_bytes_n"`
+	UnevictableParsedBytes string `yaml:"Unevictable_parsed_bytes"`
+	// Mlocked is memory locked with mlock.
+	Mlocked            string `yaml:"Mlocked"`
+	MlockedBytesN      uint64 `yaml:"Mlocked_bytes_n"`
+	MlockedParsedBytes string `yaml:"Mlocked_parsed_bytes"`
+	// SwapTotal is total amount of swap space available.
+	SwapTotal            string `yaml:"SwapTotal"`
+	SwapTotalBytesN      uint64 `yaml:"SwapTotal_bytes_n"`
+	SwapTotalParsedBytes string `yaml:"SwapTotal_parsed_bytes"`
+	// SwapFree is amount of swap space that is currently unused.
+	SwapFree            string `yaml:"SwapFree"`
+	SwapFreeBytesN      uint64 `yaml:"SwapFree_bytes_n"`
+	SwapFreeParsedBytes string `yaml:"SwapFree_parsed_bytes"`
+	// Dirty is memory which is waiting to get written back to the disk.
+	Dirty            string `yaml:"Dirty"`
+	DirtyBytesN      uint64 `yaml:"Dirty_bytes_n"`
+	DirtyParsedBytes string `yaml:"Dirty_parsed_bytes"`
+	// Writeback is memory which is actively being written back to the disk.
+	Writeback            string `yaml:"Writeback"`
+	WritebackBytesN      uint64 `yaml:"Writeback_bytes_n"`
+	WritebackParsedBytes string `yaml:"Writeback_parsed_bytes"`
+	// AnonPages is non-file backed pages mapped into user-space page tables.
+	AnonPages            string `yaml:"AnonPages"`
+	AnonPagesBytesN      uint64 `yaml:"AnonPages_bytes_n"`
+	AnonPagesParsedBytes string `yaml:"AnonPages_parsed_bytes"`
+	// Mapped is files which have been mapped into memory (with mmap), such as libraries.
+	Mapped            string `yaml:"Mapped"`
+	MappedBytesN      uint64 `yaml:"Mapped_bytes_n"`
+	MappedParsedBytes string `yaml:"Mapped_parsed_bytes"`
+	// Shmem is amount of memory consumed in tmpfs filesystems.
+	Shmem            string `yaml:"Shmem"`
+	ShmemBytesN      uint64 `yaml:"Shmem_bytes_n"`
+	ShmemParsedBytes string `yaml:"Shmem_parsed_bytes"`
+	// KReclaimable is kernel allocations that the kernel will attempt to reclaim under memory pressure.
+	KReclaimable            string `yaml:"KReclaimable"`
+	KReclaimableBytesN      uint64 `yaml:"KReclaimable_bytes_n"`
+	KReclaimableParsedBytes string `yaml:"KReclaimable_parsed_bytes"`
+	// Slab is in-kernel data structures cache.
+	Slab            string `yaml:"Slab"`
+	SlabBytesN      uint64 `yaml:"Slab_bytes_n"`
+	SlabParsedBytes string `yaml:"Slab_parsed_bytes"`
+	// SReclaimable is part of Slab, that might be reclaimed, such as caches.
+	SReclaimable            string `yaml:"SReclaimable"`
+	SReclaimableBytesN      uint64 `yaml:"SReclaimable_bytes_n"`
+	SReclaimableParsedBytes string `yaml:"SReclaimable_parsed_bytes"`
+	// SUnreclaim is part of Slab, that cannot be reclaimed on memory pressure.
+	SUnreclaim            string `yaml:"SUnreclaim"`
+	SUnreclaimBytesN      uint64 `yaml:"SUnreclaim_bytes_n"`
+	SUnreclaimParsedBytes string `yaml:"SUnreclaim_parsed_bytes"`
+	// KernelStack is amount of memory allocated to kernel stacks.
+	KernelStack            string `yaml:"KernelStack"`
+	KernelStackBytesN      uint64 `yaml:"KernelStack_bytes_n"`
+	KernelStackParsedBytes string `yaml:"KernelStack_parsed_bytes"`
+	// PageTables is amount of memory dedicated to the lowest level of page tables.
+	PageTables            string `yaml:"PageTables"`
+	PageTablesBytesN      uint64 `yaml:"PageTables_bytes_n"`
+	PageTablesParsedBytes string `yaml:"PageTables_parsed_bytes"`
+	// NFSUnstable is NFS pages sent to the server, but not yet committed to stable storage.
+	NFSUnstable            string `yaml:"NFS_Unstable"`
+	NFSUnstableBytesN      uint64 `yaml:"NFS_Unstable_bytes_n"`
+	NFSUnstableParsedBytes string `yaml:"NFS_Unstable_parsed_bytes"`
+	// Bounce is memory used for block device bounce buffers.
+	Bounce            string `yaml:"Bounce"`
+	BounceBytesN      uint64 `yaml:"Bounce_bytes_n"`
+	BounceParsedBytes string `yaml:"Bounce_parsed_bytes"`
+	// WritebackTmp is memory used by FUSE for temporary writeback buffers.
+	WritebackTmp            string `yaml:"WritebackTmp"`
+	WritebackTmpBytesN      uint64 `yaml:"WritebackTmp_bytes_n"`
+	WritebackTmpParsedBytes string `yaml:"WritebackTmp_parsed_bytes"`
+	// CommitLimit is total amount of memory currently available to be allocated on the system, based on the overcommit ratio.
+	CommitLimit            string `yaml:"CommitLimit"`
+	CommitLimitBytesN      uint64 `yaml:"CommitLimit_bytes_n"`
+	CommitLimitParsedBytes string `yaml:"CommitLimit_parsed_bytes"`
+	// CommittedAS is amount of memory presently allocated on the system.
+	CommittedAS            string `yaml:"Committed_AS"`
+	CommittedASBytesN      uint64 `yaml:"Committed_AS_bytes_n"`
+	CommittedASParsedBytes string `yaml:"Committed_AS_parsed_bytes"`
+	// VmallocTotal is total size of vmalloc memory area.
+	VmallocTotal            string `yaml:"VmallocTotal"`
+	VmallocTotalBytesN      uint64 `yaml:"VmallocTotal_bytes_n"`
+	VmallocTotalParsedBytes string `yaml:"VmallocTotal_parsed_bytes"`
+	// VmallocUsed is amount of vmalloc area which is used.
+	VmallocUsed            string `yaml:"VmallocUsed"`
+	VmallocUsedBytesN      uint64 `yaml:"VmallocUsed_bytes_n"`
+	VmallocUsedParsedBytes string `yaml:"VmallocUsed_parsed_bytes"`
+	// VmallocChunk is largest contiguous block of vmalloc area which is free.
+	VmallocChunk            string `yaml:"VmallocChunk"`
+	VmallocChunkBytesN      uint64 `yaml:"VmallocChunk_bytes_n"`
+	VmallocChunkParsedBytes string `yaml:"VmallocChunk_parsed_bytes"`
+	// Percpu is memory allocated to the per-cpu allocator used to back per-cpu allocations.
+	Percpu            string `yaml:"Percpu"`
+	PercpuBytesN      uint64 `yaml:"Percpu_bytes_n"`
+	PercpuParsedBytes string `yaml:"Percpu_parsed_bytes"`
+	// HardwareCorrupted is amount of memory the kernel identifies as corrupted.
+	HardwareCorrupted            string `yaml:"HardwareCorrupted"`
+	HardwareCorruptedBytesN      uint64 `yaml:"HardwareCorrupted_bytes_n"`
+	HardwareCorruptedParsedBytes string `yaml:"HardwareCorrupted_parsed_bytes"`
+	// AnonHugePages is non-file backed huge pages mapped into user-space page tables.
+	AnonHugePages            string `yaml:"AnonHugePages"`
+	AnonHugePagesBytesN      uint64 `yaml:"AnonHugePages_bytes_n"`
+	AnonHugePagesParsedBytes string `yaml:"AnonHugePages_parsed_bytes"`
+	// ShmemHugePages is memory used by shared memory (shmem) and tmpfs allocated with huge pages.
+	ShmemHugePages            string `yaml:"ShmemHugePages"`
+	ShmemHugePagesBytesN      uint64 `yaml:"ShmemHugePages_bytes_n"`
+	ShmemHugePagesParsedBytes string `yaml:"ShmemHugePages_parsed_bytes"`
+	// ShmemPmdMapped is shared memory mapped into user space with huge pages.
+	ShmemPmdMapped            string `yaml:"ShmemPmdMapped"`
+	ShmemPmdMappedBytesN      uint64 `yaml:"ShmemPmdMapped_bytes_n"`
+	ShmemPmdMappedParsedBytes string `yaml:"ShmemPmdMapped_parsed_bytes"`
+	// CmaTotal is total CMA (Contiguous Memory Allocator) pages.
+	CmaTotal            string `yaml:"CmaTotal"`
+	CmaTotalBytesN      uint64 `yaml:"CmaTotal_bytes_n"`
+	CmaTotalParsedBytes string `yaml:"CmaTotal_parsed_bytes"`
+	// CmaFree is free CMA (Contiguous Memory Allocator) pages.
+	CmaFree            string `yaml:"CmaFree"`
+	CmaFreeBytesN      uint64 `yaml:"CmaFree_bytes_n"`
+	CmaFreeParsedBytes string `yaml:"CmaFree_parsed_bytes"`
+	// HugePagesTotal is size of the pool of huge pages.
+	HugePagesTotal uint64 `yaml:"HugePages_Total"`
+	// HugePagesFree is number of huge pages in the pool that are not yet allocated.
+	HugePagesFree uint64 `yaml:"HugePages_Free"`
+	// HugePagesRsvd is number of huge pages for which a commitment to allocate from the pool has been made, but no allocation has yet been made.
+	HugePagesRsvd uint64 `yaml:"HugePages_Rsvd"`
+	// HugePagesSurp is number of huge pages in the pool above the value in /proc/sys/vm/nr_hugepages.
+	HugePagesSurp uint64 `yaml:"HugePages_Surp"`
+	// Hugepagesize is size of huge pages.
+	Hugepagesize            string `yaml:"Hugepagesize"`
+	HugepagesizeBytesN      uint64 `yaml:"Hugepagesize_bytes_n"`
+	HugepagesizeParsedBytes string `yaml:"Hugepagesize_parsed_bytes"`
+	// Hugetlb is total amount of memory consumed by huge pages of all sizes.
+	Hugetlb            string `yaml:"Hugetlb"`
+	HugetlbBytesN      uint64 `yaml:"Hugetlb_bytes_n"`
+	HugetlbParsedBytes string `yaml:"Hugetlb_parsed_bytes"`
+	// DirectMap4k is number of bytes of RAM linearly mapped by kernel in 4kB pages.
+	DirectMap4k            string `yaml:"DirectMap4k"`
+	DirectMap4kBytesN      uint64 `yaml:"DirectMap4k_bytes_n"`
+	DirectMap4kParsedBytes string `yaml:"DirectMap4k_parsed_bytes"`
+	// DirectMap2M is number of bytes of RAM linearly mapped by kernel in 2MB pages.
+	DirectMap2M            string `yaml:"DirectMap2M"`
+	DirectMap2MBytesN      uint64 `yaml:"DirectMap2M_bytes_n"`
+	DirectMap2MParsedBytes string `yaml:"DirectMap2M_parsed_bytes"`
+	// DirectMap1G is number of bytes of RAM linearly mapped by kernel in 1GB pages.
+	DirectMap1G            string `yaml:"DirectMap1G"`
+	DirectMap1GBytesN      uint64 `yaml:"DirectMap1G_bytes_n"`
+	DirectMap1GParsedBytes string `yaml:"DirectMap1G_parsed_bytes"`
+}
+
+// Smaps is a mapping in '/proc/$PID/smaps' in Linux,
+// or the sum of all mappings in '/proc/$PID/smaps_rollup'.
+type Smaps struct {
+	// Size is size of the mapping (not set in smaps_rollup).
+	Size            string `yaml:"Size"`
+	SizeBytesN      uint64 `yaml:"Size_bytes_n"`
+	SizeParsedBytes string `yaml:"Size_parsed_bytes"`
+	// Rss is amount of the mapping that is currently resident in RAM.
+	Rss            string `yaml:"Rss"`
+	RssBytesN      uint64 `yaml:"Rss_bytes_n"`
+	RssParsedBytes string `yaml:"Rss_parsed_bytes"`
+	// Pss is proportional share of the mapping, where each shared page is divided by the number of processes sharing it.
+	Pss            string `yaml:"Pss"`
+	PssBytesN      uint64 `yaml:"Pss_bytes_n"`
+	PssParsedBytes string `yaml:"Pss_parsed_bytes"`
+	// SharedClean is clean pages in the mapping that are shared with other processes.
+	SharedClean            string `yaml:"Shared_Clean"`
+	SharedCleanBytesN      uint64 `yaml:"Shared_Clean_bytes_n"`
+	SharedCleanParsedBytes string `yaml:"Shared_Clean_parsed_bytes"`
+	// SharedDirty is dirty pages in the mapping that are shared with other processes.
+	SharedDirty            string `yaml:"Shared_Dirty"`
+	SharedDirtyBytesN      uint64 `yaml:"Shared_Dirty_bytes_n"`
+	SharedDirtyParsedBytes string `yaml:"Shared_Dirty_parsed_bytes"`
+	// PrivateClean is clean pages in the mapping that are only used by this process.
+	PrivateClean            string `yaml:"Private_Clean"`
+	PrivateCleanBytesN      uint64 `yaml:"Private_Clean_bytes_n"`
+	PrivateCleanParsedBytes string `yaml:"Private_Clean_parsed_bytes"`
+	// PrivateDirty is dirty pages in the mapping that are only used by this process.
+	PrivateDirty            string `yaml:"Private_Dirty"`
+	PrivateDirtyBytesN      uint64 `yaml:"Private_Dirty_bytes_n"`
+	PrivateDirtyParsedBytes string `yaml:"Private_Dirty_parsed_bytes"`
+	// Referenced is amount of memory currently marked as referenced or accessed.
+	Referenced            string `yaml:"Referenced"`
+	ReferencedBytesN      uint64 `yaml:"Referenced_bytes_n"`
+	ReferencedParsedBytes string `yaml:"Referenced_parsed_bytes"`
+	// Anonymous is amount of memory that does not belong to any file.
+	Anonymous            string `yaml:"Anonymous"`
+	AnonymousBytesN      uint64 `yaml:"Anonymous_bytes_n"`
+	AnonymousParsedBytes string `yaml:"Anonymous_parsed_bytes"`
+	// AnonHugePages is amount of memory backed by transparent huge pages.
+	AnonHugePages            string `yaml:"AnonHugePages"`
+	AnonHugePagesBytesN      uint64 `yaml:"AnonHugePages_bytes_n"`
+	AnonHugePagesParsedBytes string `yaml:"AnonHugePages_parsed_bytes"`
+	// Swap is amount of would-be-anonymous memory that is swapped out.
+	Swap            string `yaml:"Swap"`
+	SwapBytesN      uint64 `yaml:"Swap_bytes_n"`
+	SwapParsedBytes string `yaml:"Swap_parsed_bytes"`
+	// SwapPss is proportional share of the swapped out memory.
+	SwapPss            string `yaml:"SwapPss"`
+	SwapPssBytesN      uint64 `yaml:"SwapPss_bytes_n"`
+	SwapPssParsedBytes string `yaml:"SwapPss_parsed_bytes"`
+	// Locked is amount of memory locked in RAM.
+	Locked            string `yaml:"Locked"`
+	LockedBytesN      uint64 `yaml:"Locked_bytes_n"`
+	LockedParsedBytes string `yaml:"Locked_parsed_bytes"`
+}
+
+// PressureStat is a 'some' or 'full' line in '/proc/pressure/*' in Linux.
+type PressureStat struct {
+	// Avg10 is percentage of time stalled over the last 10 seconds.
+	Avg10 float64 `column:"avg10"`
+	// Avg60 is percentage of time stalled over the last 60 seconds.
+	Avg60 float64 `column:"avg60"`
+	// Avg300 is percentage of time stalled over the last 300 seconds.
+	Avg300 float64 `column:"avg300"`
+	// Total is total stall time in microseconds.
+	Total           uint64 `column:"total"`
+	TotalParsedTime string `column:"total_parsed_time"`
+}
+
+// NetSnmpIP is the 'Ip' section in '/proc/net/snmp' in Linux.
+type NetSnmpIP struct {
+	// Forwarding is 1 if forwarding IP datagrams, 2 otherwise.
+	Forwarding uint64 `yaml:"Forwarding"`
+	// DefaultTTL is default TTL of IP datagrams originated by this host.
+	DefaultTTL uint64 `yaml:"DefaultTTL"`
+	// InReceives is total number of input datagrams received from interfaces.
+	InReceives uint64 `yaml:"InReceives"`
+	// InHdrErrors is number of input datagrams discarded due to errors in IP headers.
+	InHdrErrors uint64 `yaml:"InHdrErrors"`
+	// InAddrErrors is number of input datagrams discarded due to invalid destination addresses.
+	InAddrErrors uint64 `yaml:"InAddrErrors"`
+	// ForwDatagrams is number of input datagrams forwarded.
+	ForwDatagrams uint64 `yaml:"ForwDatagrams"`
+	// InUnknownProtos is number of input datagrams discarded due to unknown or unsupported protocols.
+	InUnknownProtos uint64 `yaml:"InUnknownProtos"`
+	// InDiscards is number of input datagrams discarded for no problems (e.g. lack of buffer space).
+	InDiscards uint64 `yaml:"InDiscards"`
+	// InDelivers is total number of input datagrams delivered to IP user-protocols.
+	InDelivers uint64 `yaml:"InDelivers"`
+	// OutRequests is total number of datagrams supplied to IP for transmission.
+	OutRequests uint64 `yaml:"OutRequests"`
+	// OutDiscards is number of output datagrams discarded for no problems (e.g. lack of buffer space).
+	OutDiscards uint64 `yaml:"OutDiscards"`
+	// OutNoRoutes is number of datagrams discarded because no route could be found.
+	OutNoRoutes uint64 `yaml:"OutNoRoutes"`
+	// ReasmTimeout is maximum number of seconds received fragments are held for reassembly.
+	ReasmTimeout uint64 `yaml:"ReasmTimeout"`
+	// ReasmReqds is number of fragments received which needed to be reassembled.
+	ReasmReqds uint64 `yaml:"ReasmReqds"`
+	// ReasmOKs is number of datagrams successfully reassembled.
+	ReasmOKs uint64 `yaml:"ReasmOKs"`
+	// ReasmFails is number of failures detected by the reassembly algorithm.
+	ReasmFails uint64 `yaml:"ReasmFails"`
+	// FragOKs is number of datagrams successfully fragmented.
+	FragOKs uint64 `yaml:"FragOKs"`
+	// FragFails is number of datagrams discarded because they could not be fragmented.
+	FragFails uint64 `yaml:"FragFails"`
+	// FragCreates is number of datagram fragments generated by fragmentation.
+	FragCreates uint64 `yaml:"FragCreates"`
+	// OutTransmits is number of datagrams passed to the link layer, including fragments.
+	OutTransmits uint64 `yaml:"OutTransmits"`
+}
+
+// NetSnmpICMP is the 'Icmp' section in '/proc/net/snmp' in Linux.
+type NetSnmpICMP struct {
+	// InMsgs is total number of ICMP messages received.
+	InMsgs uint64 `yaml:"InMsgs"`
+	// InErrors is number of ICMP messages received but determined as having errors.
+	InErrors uint64 `yaml:"InErrors"`
+	// InCsumErrors is number of ICMP messages received with bad checksums.
+	InCsumErrors uint64 `yaml:"InCsumErrors"`
+	// InDestUnreachs is number of ICMP Destination Unreachable messages received.
+	InDestUnreachs uint64 `yaml:"InDestUnreachs"`
+	// InTimeExcds is number of ICMP Time Exceeded messages received.
+	InTimeExcds uint64 `yaml:"InTimeExcds"`
+	// InParmProbs is number of ICMP Parameter Problem messages received.
+	InParmProbs uint64 `yaml:"InParmProbs"`
+	// InSrcQuenchs is number of ICMP Source Quench messages received.
+	InSrcQuenchs uint64 `yaml:"InSrcQuenchs"`
+	// InRedirects is number of ICMP Redirect messages received.
+	InRedirects uint64 `yaml:"InRedirects"`
+	// InEchos is number of ICMP Echo request messages received.
+	InEchos uint64 `yaml:"InEchos"`
+	// InEchoReps is number of ICMP Echo Reply messages received.
+	InEchoReps uint64 `yaml:"InEchoReps"`
+	// OutMsgs is total number of ICMP messages attempted to send.
+	OutMsgs uint64 `yaml:"OutMsgs"`
+	// OutErrors is number of ICMP messages not sent due to problems within ICMP.
+	OutErrors uint64 `yaml:"OutErrors"`
+	// OutDestUnreachs is number of ICMP Destination Unreachable messages sent.
+	OutDestUnreachs uint64 `yaml:"OutDestUnreachs"`
+	// OutTimeExcds is number of ICMP Time Exceeded messages sent.
+	OutTimeExcds uint64 `yaml:"OutTimeExcds"`
+	// OutParmProbs is number of ICMP Parameter Problem messages sent.
+	OutParmProbs uint64 `yaml:"OutParmProbs"`
+	// OutSrcQuenchs is number of ICMP Source Quench messages sent.
+	OutSrcQuenchs uint64 `yaml:"OutSrcQuenchs"`
+	// OutRedirects is number of ICMP Redirect messages sent.
+	OutRedirects uint64 `yaml:"OutRedirects"`
+	// OutEchos is number of ICMP Echo request messages sent.
+	OutEchos uint64 `yaml:"OutEchos"`
+	// OutEchoReps is number of ICMP Echo Reply messages sent.
+	OutEchoReps uint64 `yaml:"OutEchoReps"`
+}
+
+// NetSnmpTCP is the 'Tcp' section in '/proc/net/snmp' in Linux.
+type NetSnmpTCP struct {
+	// RtoAlgorithm is algorithm to determine the retransmission timeout (1 for other).
+	RtoAlgorithm uint64 `yaml:"RtoAlgorithm"`
+	// RtoMin is minimum retransmission timeout in milliseconds.
+	RtoMin uint64 `yaml:"RtoMin"`
+	// RtoMax is maximum retransmission timeout in milliseconds.
+	RtoMax uint64 `yaml:"RtoMax"`
+	// MaxConn is limit on the total number of TCP connections (-1 if dynamic).
+	MaxConn int64 `yaml:"MaxConn"`
+	// ActiveOpens is number of transitions to SYN-SENT from CLOSED.
+	ActiveOpens uint64 `yaml:"ActiveOpens"`
+	// PassiveOpens is number of transitions to SYN-RCVD from LISTEN.
+	PassiveOpens uint64 `yaml:"PassiveOpens"`
+	// AttemptFails is number of failed connection attempts.
+	AttemptFails uint64 `yaml:"AttemptFails"`
+	// EstabResets is number of resets from ESTABLISHED or CLOSE-WAIT.
+	EstabResets uint64 `yaml:"EstabResets"`
+	// CurrEstab is number of connections in ESTABLISHED or CLOSE-WAIT.
+	CurrEstab uint64 `yaml:"CurrEstab"`
+	// InSegs is total number of segments received.
+	InSegs uint64 `yaml:"InSegs"`
+	// OutSegs is total number of segments sent, excluding retransmitted segments.
+	OutSegs uint64 `yaml:"OutSegs"`
+	// RetransSegs is total number of segments retransmitted.
+	RetransSegs uint64 `yaml:"RetransSegs"`
+	// InErrs is total number of segments received in error.
+	InErrs uint64 `yaml:"InErrs"`
+	// OutRsts is number of segments sent containing the RST flag.
+	OutRsts uint64 `yaml:"OutRsts"`
+	// InCsumErrors is number of segments received with bad checksums.
+	InCsumErrors uint64 `yaml:"InCsumErrors"`
+}
+
+// NetSnmpUDP is the 'Udp' or 'UdpLite' section in '/proc/net/snmp' in Linux.
+type NetSnmpUDP struct {
+	// InDatagrams is total number of datagrams delivered to users.
+	InDatagrams uint64 `yaml:"InDatagrams"`
+	// NoPorts is number of datagrams received with no application at the destination port.
+	NoPorts uint64 `yaml:"NoPorts"`
+	// InErrors is number of datagrams that could not be delivered, other than 'NoPorts'.
+	InErrors uint64 `yaml:"InErrors"`
+	// OutDatagrams is total number of datagrams sent.
+	OutDatagrams uint64 `yaml:"OutDatagrams"`
+	// RcvbufErrors is number of datagrams dropped because the socket receive buffer was full.
+	RcvbufErrors uint64 `yaml:"RcvbufErrors"`
+	// SndbufErrors is number of datagrams dropped because the socket send buffer was full.
+	SndbufErrors uint64 `yaml:"SndbufErrors"`
+	// InCsumErrors is number of datagrams received with bad checksums.
+	InCsumErrors uint64 `yaml:"InCsumErrors"`
+	// IgnoredMulti is number of broadcast or multicast datagrams ignored.
+	IgnoredMulti uint64 `yaml:"IgnoredMulti"`
+	// MemErrors is number of datagrams dropped due to memory accounting limits.
+	MemErrors uint64 `yaml:"MemErrors"`
+}
+
+// NetSnmp6 is '/proc/net/snmp6' in Linux.
+type NetSnmp6 struct {
+	// Ip6InReceives is total number of input IPv6 datagrams received.
+	Ip6InReceives uint64 `yaml:"Ip6InReceives"`
+	// Ip6InHdrErrors is number of input IPv6 datagrams discarded due to errors in headers.
+	Ip6InHdrErrors uint64 `yaml:"Ip6InHdrErrors"`
+	// Ip6InNoRoutes is number of input IPv6 datagrams discarded because no route could be found.
+	Ip6InNoRoutes uint64 `yaml:"Ip6InNoRoutes"`
+	// Ip6InAddrErrors is number of input IPv6 datagrams discarded due to invalid destination addresses.
+	Ip6InAddrErrors uint64 `yaml:"Ip6InAddrErrors"`
+	// Ip6InDiscards is number of input IPv6 datagrams discarded for no problems (e.g. lack of buffer space).
+	Ip6InDiscards uint64 `yaml:"Ip6InDiscards"`
+	// Ip6InDelivers is total number of input IPv6 datagrams delivered to user-protocols.
+	Ip6InDelivers uint64 `yaml:"Ip6InDelivers"`
+	// Ip6OutRequests is total number of IPv6 datagrams supplied for transmission.
+	Ip6OutRequests uint64 `yaml:"Ip6OutRequests"`
+	// Ip6OutDiscards is number of output IPv6 datagrams discarded for no problems.
+	Ip6OutDiscards uint64 `yaml:"Ip6OutDiscards"`
+	// Ip6OutNoRoutes is number of IPv6 datagrams discarded because no route could be found.
+	Ip6OutNoRoutes uint64 `yaml:"Ip6OutNoRoutes"`
+	// Ip6InOctets is total number of octets received in input IPv6 datagrams.
+	Ip6InOctets uint64 `yaml:"Ip6InOctets"`
+	// Ip6OutOctets is total number of octets sent in IPv6 datagrams.
+	Ip6OutOctets uint64 `yaml:"Ip6OutOctets"`
+	// Icmp6InMsgs is total number of ICMPv6 messages received.
+	Icmp6InMsgs uint64 `yaml:"Icmp6InMsgs"`
+	// Icmp6InErrors is number of ICMPv6 messages received but determined as having errors.
+	Icmp6InErrors uint64 `yaml:"Icmp6InErrors"`
+	// Icmp6OutMsgs is total number of ICMPv6 messages attempted to send.
+	Icmp6OutMsgs uint64 `yaml:"Icmp6OutMsgs"`
+	// Icmp6OutErrors is number of ICMPv6 messages not sent due to problems within ICMPv6.
+	Icmp6OutErrors uint64 `yaml:"Icmp6OutErrors"`
+	// Icmp6InDestUnreachs is number of ICMPv6 Destination Unreachable messages received.
+	Icmp6InDestUnreachs uint64 `yaml:"Icmp6InDestUnreachs"`
+	// Icmp6OutDestUnreachs is number of ICMPv6 Destination Unreachable messages sent.
+	Icmp6OutDestUnreachs uint64 `yaml:"Icmp6OutDestUnreachs"`
+	// Udp6InDatagrams is total number of UDPv6 datagrams delivered to users.
+	Udp6InDatagrams uint64 `yaml:"Udp6InDatagrams"`
+	// Udp6NoPorts is number of UDPv6 datagrams received with no application at the destination port.
+	Udp6NoPorts uint64 `yaml:"Udp6NoPorts"`
+	// Udp6InErrors is number of UDPv6 datagrams that could not be delivered, other than 'Udp6NoPorts'.
+	Udp6InErrors uint64 `yaml:"Udp6InErrors"`
+	// Udp6OutDatagrams is total number of UDPv6 datagrams sent.
+	Udp6OutDatagrams uint64 `yaml:"Udp6OutDatagrams"`
+	// Udp6RcvbufErrors is number of UDPv6 datagrams dropped because the socket receive buffer was full.
+	Udp6RcvbufErrors uint64 `yaml:"Udp6RcvbufErrors"`
+	// Udp6SndbufErrors is number of UDPv6 datagrams dropped because the socket send buffer was full.
+	Udp6SndbufErrors uint64 `yaml:"Udp6SndbufErrors"`
+	// Udp6InCsumErrors is number of UDPv6 datagrams received with bad checksums.
+	Udp6InCsumErrors uint64 `yaml:"Udp6InCsumErrors"`
+	// Udp6IgnoredMulti is number of UDPv6 multicast datagrams ignored.
+	Udp6IgnoredMulti uint64 `yaml:"Udp6IgnoredMulti"`
+	// Udp6MemErrors is number of UDPv6 datagrams dropped due to memory accounting limits.
+	Udp6MemErrors uint64 `yaml:"Udp6MemErrors"`
+}
+
+// NetstatTCPExt is the 'TcpExt' section in '/proc/net/netstat' in Linux.
+type NetstatTCPExt struct {
+	// SyncookiesSent is number of SYN cookies sent.
+	SyncookiesSent uint64 `yaml:"SyncookiesSent"`
+	// SyncookiesRecv is number of valid SYN cookies received.
+	SyncookiesRecv uint64 `yaml:"SyncookiesRecv"`
+	// SyncookiesFailed is number of invalid SYN cookies received.
+	SyncookiesFailed uint64 `yaml:"SyncookiesFailed"`
+	// EmbryonicRsts is number of resets received for embryonic SYN-RCVD sockets.
+	EmbryonicRsts uint64 `yaml:"EmbryonicRsts"`
+	// PruneCalled is number of times the receive queue was pruned due to memory pressure.
+	PruneCalled uint64 `yaml:"PruneCalled"`
+	// RcvPruned is number of packets dropped from the receive queue after pruning.
+	RcvPruned uint64 `yaml:"RcvPruned"`
+	// OfoPruned is number of packets dropped from the out-of-order queue due to memory pressure.
+	OfoPruned uint64 `yaml:"OfoPruned"`
+	// TW is number of sockets that finished TIME-WAIT via the fast timer.
+	TW uint64 `yaml:"TW"`
+	// DelayedACKs is number of delayed ACKs sent.
+	DelayedACKs uint64 `yaml:"DelayedACKs"`
+	// DelayedACKLost is number of times quick ACK mode was entered due to lost delayed ACKs.
+	DelayedACKLost uint64 `yaml:"DelayedACKLost"`
+	// ListenOverflows is number of times the accept queue of a listening socket overflowed.
+	ListenOverflows uint64 `yaml:"ListenOverflows"`
+	// ListenDrops is number of SYNs to listening sockets dropped, including 'ListenOverflows'.
+	ListenDrops uint64 `yaml:"ListenDrops"`
+	// TCPLostRetransmit is number of retransmitted segments that were lost.
+	TCPLostRetransmit uint64 `yaml:"TCPLostRetransmit"`
+	// TCPFastRetrans is number of segments retransmitted by fast retransmit.
+	TCPFastRetrans uint64 `yaml:"TCPFastRetrans"`
+	// TCPSlowStartRetrans is number of segments retransmitted in slow start.
+	TCPSlowStartRetrans uint64 `yaml:"TCPSlowStartRetrans"`
+	// TCPTimeouts is number of retransmission timeouts (RTO).
+	TCPTimeouts uint64 `yaml:"TCPTimeouts"`
+	// TCPLossProbes is number of tail loss probes sent.
+	TCPLossProbes uint64 `yaml:"TCPLossProbes"`
+	// TCPLossProbeRecovery is number of losses recovered by tail loss probes.
+	TCPLossProbeRecovery uint64 `yaml:"TCPLossProbeRecovery"`
+	// TCPSpuriousRTOs is number of spurious retransmission timeouts detected by F-RTO.
+	TCPSpuriousRTOs uint64 `yaml:"TCPSpuriousRTOs"`
+	// TCPRetransFail is number of failed retransmits (e.g. lack of memory).
+	TCPRetransFail uint64 `yaml:"TCPRetransFail"`
+	// TCPSynRetrans is number of SYN and SYN/ACK retransmits.
+	TCPSynRetrans uint64 `yaml:"TCPSynRetrans"`
+	// TCPOrigDataSent is number of outgoing packets with original data, excluding retransmits.
+	TCPOrigDataSent uint64 `yaml:"TCPOrigDataSent"`
+	// TCPRcvCollapsed is number of packets collapsed in the receive queue due to low socket buffer.
+	TCPRcvCollapsed uint64 `yaml:"TCPRcvCollapsed"`
+	// TCPBacklogDrop is number of packets dropped because the socket backlog was full.
+	TCPBacklogDrop uint64 `yaml:"TCPBacklogDrop"`
+	// TCPOFOQueue is number of packets queued in the out-of-order queue.
+	TCPOFOQueue uint64 `yaml:"TCPOFOQueue"`
+	// TCPOFODrop is number of packets dropped from the out-of-order queue due to memory limits.
+	TCPOFODrop uint64 `yaml:"TCPOFODrop"`
+	// TCPRcvQDrop is number of packets dropped because the receive queue was full.
+	TCPRcvQDrop uint64 `yaml:"TCPRcvQDrop"`
+	// TCPZeroWindowDrop is number of packets dropped due to a zero receive window.
+	TCPZeroWindowDrop uint64 `yaml:"TCPZeroWindowDrop"`
+	// TCPToZeroWindowAdv is number of times the advertised window went from non-zero to zero.
+	TCPToZeroWindowAdv uint64 `yaml:"TCPToZeroWindowAdv"`
+	// TCPReqQFullDrop is number of SYNs dropped because the SYN queue was full and SYN cookies were disabled.
+	TCPReqQFullDrop uint64 `yaml:"TCPReqQFullDrop"`
+	// TCPReqQFullDoCookies is number of SYN cookies sent because the SYN queue was full.
+	TCPReqQFullDoCookies uint64 `yaml:"TCPReqQFullDoCookies"`
+	// TCPAbortOnData is number of connections reset due to unexpected data.
+	TCPAbortOnData uint64 `yaml:"TCPAbortOnData"`
+	// TCPAbortOnClose is number of connections reset when closed with unread data.
+	TCPAbortOnClose uint64 `yaml:"TCPAbortOnClose"`
+	// TCPAbortOnMemory is number of connections aborted due to memory pressure.
+	TCPAbortOnMemory uint64 `yaml:"TCPAbortOnMemory"`
+	// TCPAbortOnTimeout is number of connections aborted after retransmission timeouts.
+	TCPAbortOnTimeout uint64 `yaml:"TCPAbortOnTimeout"`
+	// TCPAbortOnLinger is number of connections aborted after the linger timeout.
+	TCPAbortOnLinger uint64 `yaml:"TCPAbortOnLinger"`
+	// TCPAbortFailed is number of failed attempts to send a reset.
+	TCPAbortFailed uint64 `yaml:"TCPAbortFailed"`
+	// TCPMemoryPressures is number of times TCP entered memory pressure.
+	TCPMemoryPressures uint64 `yaml:"TCPMemoryPressures"`
+	// TCPKeepAlive is number of keepalive probes sent.
+	TCPKeepAlive uint64 `yaml:"TCPKeepAlive"`
+	// TCPDelivered is number of data packets delivered, including retransmits.
+	TCPDelivered uint64 `yaml:"TCPDelivered"`
+}
+
+// NetstatIPExt is the 'IpExt' section in '/proc/net/netstat' in Linux.
+type NetstatIPExt struct {
+	// InNoRoutes is number of input datagrams discarded because no route could be found.
+	InNoRoutes uint64 `yaml:"InNoRoutes"`
+	// InTruncatedPkts is number of input datagrams discarded because they were truncated.
+	InTruncatedPkts uint64 `yaml:"InTruncatedPkts"`
+	// InMcastPkts is number of multicast datagrams received.
+	InMcastPkts uint64 `yaml:"InMcastPkts"`
+	// OutMcastPkts is number of multicast datagrams sent.
+	OutMcastPkts uint64 `yaml:"OutMcastPkts"`
+	// InBcastPkts is number of broadcast datagrams received.
+	InBcastPkts uint64 `yaml:"InBcastPkts"`
+	// OutBcastPkts is number of broadcast datagrams sent.
+	OutBcastPkts uint64 `yaml:"OutBcastPkts"`
+	// InOctets is total number of octets received.
+	InOctets uint64 `yaml:"InOctets"`
+	// OutOctets is total number of octets sent.
+	OutOctets uint64 `yaml:"OutOctets"`
+	// InMcastOctets is number of octets received in multicast datagrams.
+	InMcastOctets uint64 `yaml:"InMcastOctets"`
+	// OutMcastOctets is number of octets sent in multicast datagrams.
+	OutMcastOctets uint64 `yaml:"OutMcastOctets"`
+	// InBcastOctets is number of octets received in broadcast datagrams.
+	InBcastOctets uint64 `yaml:"InBcastOctets"`
+	// OutBcastOctets is number of octets sent in broadcast datagrams.
+	OutBcastOctets uint64 `yaml:"OutBcastOctets"`
+	// InCsumErrors is number of input datagrams with bad checksums.
+	InCsumErrors uint64 `yaml:"InCsumErrors"`
+	// InNoECTPkts is number of datagrams received without ECN.
+	InNoECTPkts uint64 `yaml:"InNoECTPkts"`
+	// InECT1Pkts is number of datagrams received with ECT(1).
+	InECT1Pkts uint64 `yaml:"InECT1Pkts"`
+	// InECT0Pkts is number of datagrams received with ECT(0).
+	InECT0Pkts uint64 `yaml:"InECT0Pkts"`
+	// InCEPkts is number of datagrams received with congestion experienced (CE).
+	InCEPkts uint64 `yaml:"InCEPkts"`
+	// ReasmOverlaps is number of fragments discarded due to overlaps.
+	ReasmOverlaps uint64 `yaml:"ReasmOverlaps"`
+}
+
 // IO is '/proc/$PID/io' in Linux.
 type IO struct {
 	// Rchar is number of bytes which this task has caused to be read from storage (sum of bytes which this process passed to read).
@@ -1,7 +1,6 @@
 package proc
 
 import (
-	"fmt"
 	"io/ioutil"
 
 	"github.com/gyuho/linux-inspect/pkg/fileutil"
@@ -12,7 +11,25 @@ import (
 
 // GetIOByPID reads '/proc/$PID/io' data.
 func GetIOByPID(pid int64) (s IO, err error) {
-	fpath := fmt.Sprintf("/proc/%d/io", pid)
+	return defaultFS.GetIOByPID(pid)
+}
+
+// GetIOByPID reads '$ROOT/$PID/io' data.
+func (fs FS) GetIOByPID(pid int64) (s IO, err error) {
+	return getIO(fs.pidPath(pid, "io"))
+}
+
+// GetIOByTID reads '/proc/$PID/task/$TID/io' data.
+func GetIOByTID(pid, tid int64) (s IO, err error) {
+	return defaultFS.GetIOByTID(pid, tid)
+}
+
+// GetIOByTID reads '$ROOT/$PID/task/$TID/io' data.
+func (fs FS) GetIOByTID(pid, tid int64) (s IO, err error) {
+	return getIO(fs.taskPath(pid, tid, "io"))
+}
+
+func getIO(fpath string) (s IO, err error) {
 	f, err := fileutil.OpenToRead(fpath)
 	if err != nil {
 		return IO{}, err
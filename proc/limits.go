@@ -0,0 +1,145 @@
+package proc
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/gyuho/linux-inspect/pkg/fileutil"
+)
+
+// RLimit is a soft or hard resource limit value.
+type RLimit uint64
+
+// RLimitInfinity is 'unlimited' (RLIM_INFINITY).
+const RLimitInfinity = RLimit(math.MaxUint64)
+
+// Unlimited returns true if the limit is 'unlimited'.
+func (r RLimit) Unlimited() bool {
+	return r == RLimitInfinity
+}
+
+func (r RLimit) String() string {
+	if r.Unlimited() {
+		return "unlimited"
+	}
+	return strconv.FormatUint(uint64(r), 10)
+}
+
+// Limit is a resource limit in '/proc/$PID/limits'.
+type Limit struct {
+	// Name is the resource name, such as 'Max open files'.
+	Name string
+	Soft RLimit
+	Hard RLimit
+	// Units is such as 'seconds', 'bytes', 'files'.
+	// Empty for priorities.
+	Units string
+}
+
+// Limits is '/proc/$PID/limits' in Linux.
+// Reference http://man7.org/linux/man-pages/man2/getrlimit.2.html.
+type Limits struct {
+	CPUTime          Limit // RLIMIT_CPU
+	FileSize         Limit // RLIMIT_FSIZE
+	DataSize         Limit // RLIMIT_DATA
+	StackSize        Limit // RLIMIT_STACK
+	CoreFileSize     Limit // RLIMIT_CORE
+	ResidentSet      Limit // RLIMIT_RSS
+	Processes        Limit // RLIMIT_NPROC
+	OpenFiles        Limit // RLIMIT_NOFILE
+	LockedMemory     Limit // RLIMIT_MEMLOCK
+	AddressSpace     Limit // RLIMIT_AS
+	FileLocks        Limit // RLIMIT_LOCKS
+	PendingSignals   Limit // RLIMIT_SIGPENDING
+	MsgqueueSize     Limit // RLIMIT_MSGQUEUE
+	NicePriority     Limit // RLIMIT_NICE
+	RealtimePriority Limit // RLIMIT_RTPRIO
+	RealtimeTimeout  Limit // RLIMIT_RTTIME
+}
+
+// GetLimits reads '/proc/$PID/limits'.
+func GetLimits(pid int64) (Limits, error) {
+	return defaultFS.GetLimits(pid)
+}
+
+// GetLimits reads '$ROOT/$PID/limits'.
+func (fs FS) GetLimits(pid int64) (Limits, error) {
+	f, err := fileutil.OpenToRead(fs.pidPath(pid, "limits"))
+	if err != nil {
+		return Limits{}, err
+	}
+	defer f.Close()
+
+	d, err := ioutil.ReadAll(f)
+	if err != nil {
+		return Limits{}, err
+	}
+	return parseLimits(d)
+}
+
+// columns are separated by at least 2 spaces,
+// while resource names have single spaces
+var limitsSeparator = regexp.MustCompile(`\s{2,}`)
+
+func parseLimits(d []byte) (ls Limits, err error) {
+	fields := map[string]*Limit{
+		"Max cpu time":          &ls.CPUTime,
+		"Max file size":         &ls.FileSize,
+		"Max data size":         &ls.DataSize,
+		"Max stack size":        &ls.StackSize,
+		"Max core file size":    &ls.CoreFileSize,
+		"Max resident set":      &ls.ResidentSet,
+		"Max processes":         &ls.Processes,
+		"Max open files":        &ls.OpenFiles,
+		"Max locked memory":     &ls.LockedMemory,
+		"Max address space":     &ls.AddressSpace,
+		"Max file locks":        &ls.FileLocks,
+		"Max pending signals":   &ls.PendingSignals,
+		"Max msgqueue size":     &ls.MsgqueueSize,
+		"Max nice priority":     &ls.NicePriority,
+		"Max realtime priority": &ls.RealtimePriority,
+		"Max realtime timeout":  &ls.RealtimeTimeout,
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(d))
+	for scanner.Scan() {
+		txt := strings.TrimSpace(scanner.Text())
+		if txt == "" || strings.HasPrefix(txt, "Limit ") {
+			continue
+		}
+		fs := limitsSeparator.Split(txt, -1)
+		if len(fs) < 3 {
+			return Limits{}, fmt.Errorf("not enough fields at %q", txt)
+		}
+		l, ok := fields[fs[0]]
+		if !ok {
+			// unknown resource in newer kernels
+			continue
+		}
+		l.Name = fs[0]
+		if l.Soft, err = parseRLimit(fs[1]); err != nil {
+			return Limits{}, err
+		}
+		if l.Hard, err = parseRLimit(fs[2]); err != nil {
+			return Limits{}, err
+		}
+		if len(fs) > 3 {
+			l.Units = fs[3]
+		}
+	}
+	return ls, scanner.Err()
+}
+
+func parseRLimit(s string) (RLimit, error) {
+	if s == "unlimited" {
+		return RLimitInfinity, nil
+	}
+	v, err := strconv.ParseUint(s, 10, 64)
+	return RLimit(v), err
+}
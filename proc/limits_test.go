@@ -0,0 +1,62 @@
+package proc
+
+import (
+	"os"
+	"testing"
+)
+
+const testLimits = `Limit                     Soft Limit           Hard Limit           Units     
+Max cpu time              unlimited            unlimited            seconds   
+Max file size             unlimited            unlimited            bytes     
+Max data size             unlimited            unlimited            bytes     
+Max stack size            8388608              unlimited            bytes     
+Max core file size        0                    unlimited            bytes     
+Max resident set          unlimited            unlimited            bytes     
+Max processes             63619                63619                processes 
+Max open files            1024                 1048576              files     
+Max locked memory         8388608              8388608              bytes     
+Max address space         unlimited            unlimited            bytes     
+Max file locks            unlimited            unlimited            locks     
+Max pending signals       63619                63619                signals   
+Max msgqueue size         819200               819200               bytes     
+Max nice priority         0                    0                    
+Max realtime priority     0                    0                    
+Max realtime timeout      unlimited            unlimited            us        
+`
+
+func TestParseLimits(t *testing.T) {
+	ls, err := parseLimits([]byte(testLimits))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := Limit{Name: "Max open files", Soft: 1024, Hard: 1048576, Units: "files"}
+	if ls.OpenFiles != expected {
+		t.Fatalf("expected %+v, got %+v", expected, ls.OpenFiles)
+	}
+	if ls.StackSize.Soft != 8388608 || !ls.StackSize.Hard.Unlimited() {
+		t.Fatalf("unexpected %+v", ls.StackSize)
+	}
+	if !ls.AddressSpace.Soft.Unlimited() || ls.AddressSpace.Soft.String() != "unlimited" {
+		t.Fatalf("unexpected %+v", ls.AddressSpace)
+	}
+	if ls.NicePriority.Name != "Max nice priority" || ls.NicePriority.Units != "" {
+		t.Fatalf("unexpected %+v", ls.NicePriority)
+	}
+	if ls.RealtimeTimeout.Units != "us" {
+		t.Fatalf("unexpected %+v", ls.RealtimeTimeout)
+	}
+
+	if _, err = parseLimits([]byte("Max open files            x                    1024                 files\n")); err == nil {
+		t.Fatal("expected error for invalid limit")
+	}
+}
+
+func TestGetLimits(t *testing.T) {
+	ls, err := GetLimits(int64(os.Getpid()))
+	if err != nil {
+		t.Skip(err)
+	}
+	if ls.OpenFiles.Soft == 0 || ls.OpenFiles.Soft > ls.OpenFiles.Hard {
+		t.Fatalf("unexpected %+v", ls.OpenFiles)
+	}
+}
@@ -2,6 +2,7 @@ package proc
 
 import (
 	"io/ioutil"
+	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
@@ -9,7 +10,28 @@ import (
 
 // ListPIDs reads all PIDs in '/proc'.
 func ListPIDs() ([]int64, error) {
-	ds, err := ioutil.ReadDir("/proc")
+	return defaultFS.ListPIDs()
+}
+
+// ListPIDs reads all PIDs in '$ROOT'.
+func (fs FS) ListPIDs() ([]int64, error) {
+	return listIDs(fs.root)
+}
+
+// ListTIDs reads all thread IDs in '/proc/$PID/task'.
+// The thread group leader has the same TID as the PID.
+func ListTIDs(pid int64) ([]int64, error) {
+	return defaultFS.ListTIDs(pid)
+}
+
+// ListTIDs reads all thread IDs in '$ROOT/$PID/task'.
+func (fs FS) ListTIDs(pid int64) ([]int64, error) {
+	return listIDs(fs.pidPath(pid, "task"))
+}
+
+// listIDs lists the numeric directory names.
+func listIDs(dir string) ([]int64, error) {
+	ds, err := ioutil.ReadDir(dir)
 	if err != nil {
 		return nil, err
 	}
@@ -29,16 +51,85 @@ func ListPIDs() ([]int64, error) {
 
 // ListFds reads '/proc/*/fd/*' to grab process IDs.
 func ListFds() ([]string, error) {
+	return defaultFS.ListFds()
+}
+
+// ListFds reads '$ROOT/*/fd/*' to grab process IDs.
+func (fs FS) ListFds() ([]string, error) {
 	// returns the names of all files matching pattern
 	// or nil if there is no matching file
-	fs, err := filepath.Glob("/proc/[0-9]*/fd/[0-9]*")
+	fds, err := filepath.Glob(fs.path("[0-9]*", "fd", "[0-9]*"))
+	if err != nil {
+		return nil, err
+	}
+	return fds, nil
+}
+
+// GetSocketInodes reads the 'socket:[N]' links under '/proc/*/fd',
+// and maps each socket inode to the PIDs that own the socket.
+// Reading other users' file descriptors needs root permission.
+func GetSocketInodes() (map[string][]int64, error) {
+	return defaultFS.GetSocketInodes()
+}
+
+// GetSocketInodes reads the 'socket:[N]' links under '$ROOT/*/fd'.
+func (fs FS) GetSocketInodes() (map[string][]int64, error) {
+	fds, err := fs.ListFds()
 	if err != nil {
 		return nil, err
 	}
-	return fs, nil
+	return socketInodes(fds), nil
+}
+
+// GetSocketInodesByPID reads the 'socket:[N]' links under '/proc/$PID/fd'.
+func GetSocketInodesByPID(pid int64) (map[string][]int64, error) {
+	return defaultFS.GetSocketInodesByPID(pid)
+}
+
+// GetSocketInodesByPID reads the 'socket:[N]' links under '$ROOT/$PID/fd'.
+func (fs FS) GetSocketInodesByPID(pid int64) (map[string][]int64, error) {
+	fds, err := filepath.Glob(fs.pidPath(pid, "fd", "[0-9]*"))
+	if err != nil {
+		return nil, err
+	}
+	return socketInodes(fds), nil
+}
+
+func socketInodes(fds []string) map[string][]int64 {
+	inodes := make(map[string][]int64)
+	for _, fd := range fds {
+		// process may have exited, or closed the file
+		sym, err := os.Readlink(fd)
+		if err != nil {
+			continue
+		}
+		inode, ok := parseSocketInode(sym)
+		if !ok {
+			continue
+		}
+		pid, err := pidFromFd(fd)
+		if err != nil {
+			continue
+		}
+		ps := inodes[inode]
+		if len(ps) > 0 && ps[len(ps)-1] == pid {
+			// same process, another file descriptor
+			continue
+		}
+		inodes[inode] = append(ps, pid)
+	}
+	return inodes
+}
+
+// parseSocketInode returns '12345' from 'socket:[12345]'.
+func parseSocketInode(s string) (string, bool) {
+	if !strings.HasPrefix(s, "socket:[") || !strings.HasSuffix(s, "]") {
+		return "", false
+	}
+	return s[len("socket:[") : len(s)-1], true
 }
 
 func pidFromFd(s string) (int64, error) {
 	// get 5261 from '/proc/5261/fd/69'
-	return strconv.ParseInt(strings.Split(s, "/")[2], 10, 64)
+	return strconv.ParseInt(filepath.Base(filepath.Dir(filepath.Dir(s))), 10, 64)
 }
@@ -2,6 +2,8 @@ package proc
 
 import (
 	"fmt"
+	"net"
+	"os"
 	"testing"
 )
 
@@ -12,3 +14,43 @@ func TestListPIDs(t *testing.T) {
 	}
 	fmt.Println("ListPIDs:", pids)
 }
+
+func TestGetSocketInodes(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+
+	pid := int64(os.Getpid())
+	inodes, err := GetSocketInodesByPID(pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	port := int64(ln.Addr().(*net.TCPAddr).Port)
+	nss, err := GetNetTCPByPID(pid, TypeTCP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	found := false
+	for _, ns := range nss {
+		if ns.LocalAddressParsedIPPort != port {
+			continue
+		}
+		ps := inodes[ns.Inode]
+		if len(ps) != 1 || ps[0] != pid {
+			t.Fatalf("inode %s expected owner %d, got %v", ns.Inode, pid, ps)
+		}
+		found = true
+	}
+	if !found {
+		t.Fatalf("listener on port %d not found", port)
+	}
+
+	all, err := GetSocketInodes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	fmt.Println("GetSocketInodes:", len(all))
+}
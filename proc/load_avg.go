@@ -22,15 +22,20 @@ const (
 // GetLoadAvg reads '/proc/loadavg'.
 // Expected output is '0.37 0.47 0.39 1/839 31397'.
 func GetLoadAvg() (LoadAvg, error) {
-	txt, err := readLoadAvg()
+	return defaultFS.GetLoadAvg()
+}
+
+// GetLoadAvg reads '$ROOT/loadavg'.
+func (fs FS) GetLoadAvg() (LoadAvg, error) {
+	txt, err := fs.readLoadAvg()
 	if err != nil {
 		return LoadAvg{}, err
 	}
 	return getLoadAvg(txt)
 }
 
-func readLoadAvg() (string, error) {
-	f, err := fileutil.OpenToRead("/proc/loadavg")
+func (fs FS) readLoadAvg() (string, error) {
+	f, err := fileutil.OpenToRead(fs.path("loadavg"))
 	if err != nil {
 		return "", err
 	}
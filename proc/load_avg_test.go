@@ -7,7 +7,7 @@ import (
 )
 
 func TestGetLoadAvg(t *testing.T) {
-	txt, err := readLoadAvg()
+	txt, err := defaultFS.readLoadAvg()
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -0,0 +1,110 @@
+package proc
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"github.com/gyuho/linux-inspect/pkg/fileutil"
+)
+
+// Map is a memory mapping in '/proc/$PID/maps'.
+type Map struct {
+	StartAddress uint64
+	EndAddress   uint64
+	// Perms is the permissions, such as 'r-xp'
+	// ('p' for private, 's' for shared).
+	Perms  string
+	Offset uint64
+	// Device is 'major:minor' of the mapped file.
+	Device string
+	Inode  uint64
+	// Pathname is the mapped file, or pseudo-path such as '[heap]',
+	// '[stack]'. Empty for anonymous mappings.
+	Pathname string
+}
+
+// GetMaps reads '/proc/$PID/maps'.
+func GetMaps(pid int64) ([]Map, error) {
+	return defaultFS.GetMaps(pid)
+}
+
+// GetMaps reads '$ROOT/$PID/maps'.
+func (fs FS) GetMaps(pid int64) ([]Map, error) {
+	d, err := readMaps(fs.pidPath(pid, "maps"))
+	if err != nil {
+		return nil, err
+	}
+	return parseMaps(d)
+}
+
+func readMaps(fpath string) ([]byte, error) {
+	f, err := fileutil.OpenToRead(fpath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ioutil.ReadAll(f)
+}
+
+func parseMaps(d []byte) ([]Map, error) {
+	var ms []Map
+	scanner := bufio.NewScanner(bytes.NewReader(d))
+	for scanner.Scan() {
+		txt := scanner.Text()
+		if strings.TrimSpace(txt) == "" {
+			continue
+		}
+		m, err := parseMapsLine(txt)
+		if err != nil {
+			return nil, err
+		}
+		ms = append(ms, m)
+	}
+	return ms, scanner.Err()
+}
+
+// parseMapsLine parses
+// '00400000-00452000 r-xp 00000000 08:02 173521      /usr/bin/dbus-daemon'.
+// The pathname is padded with spaces, and may contain spaces.
+func parseMapsLine(txt string) (Map, error) {
+	fs := strings.SplitN(txt, " ", 6)
+	if len(fs) < 5 {
+		return Map{}, fmt.Errorf("not enough fields at %q", txt)
+	}
+	addrs := strings.Split(fs[0], "-")
+	if len(addrs) != 2 {
+		return Map{}, fmt.Errorf("unknown address range %q", fs[0])
+	}
+	start, err := strconv.ParseUint(addrs[0], 16, 64)
+	if err != nil {
+		return Map{}, err
+	}
+	end, err := strconv.ParseUint(addrs[1], 16, 64)
+	if err != nil {
+		return Map{}, err
+	}
+	offset, err := strconv.ParseUint(fs[2], 16, 64)
+	if err != nil {
+		return Map{}, err
+	}
+	inode, err := strconv.ParseUint(fs[4], 10, 64)
+	if err != nil {
+		return Map{}, err
+	}
+	m := Map{
+		StartAddress: start,
+		EndAddress:   end,
+		Perms:        fs[1],
+		Offset:       offset,
+		Device:       fs[3],
+		Inode:        inode,
+	}
+	if len(fs) == 6 {
+		m.Pathname = strings.TrimLeft(fs[5], " ")
+	}
+	return m, nil
+}
@@ -0,0 +1,63 @@
+package proc
+
+import (
+	"os"
+	"testing"
+)
+
+const testMaps = `00400000-00452000 r-xp 00000000 08:02 173521      /usr/bin/dbus-daemon
+00651000-00652000 r--p 00051000 08:02 173521      /usr/bin/dbus-daemon
+00e03000-00e24000 rw-p 00000000 00:00 0           [heap]
+7f1c3a200000-7f1c3a600000 rw-p 00000000 00:00 0 
+7f1c3b000000-7f1c3b001000 rw-s 00000000 00:05 1234        /memfd:my buffer (deleted)
+`
+
+func TestParseMaps(t *testing.T) {
+	ms, err := parseMaps([]byte(testMaps))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ms) != 5 {
+		t.Fatalf("len(ms) expected 5, got %d", len(ms))
+	}
+	expected := Map{
+		StartAddress: 0x00651000,
+		EndAddress:   0x00652000,
+		Perms:        "r--p",
+		Offset:       0x51000,
+		Device:       "08:02",
+		Inode:        173521,
+		Pathname:     "/usr/bin/dbus-daemon",
+	}
+	if ms[1] != expected {
+		t.Fatalf("expected %+v, got %+v", expected, ms[1])
+	}
+	if ms[2].Pathname != "[heap]" {
+		t.Fatalf("pathname expected '[heap]', got %q", ms[2].Pathname)
+	}
+	if ms[3].Pathname != "" || ms[3].Inode != 0 {
+		t.Fatalf("unexpected anonymous mapping %+v", ms[3])
+	}
+	if ms[4].Pathname != "/memfd:my buffer (deleted)" {
+		t.Fatalf("pathname expected '/memfd:my buffer (deleted)', got %q", ms[4].Pathname)
+	}
+
+	if _, err = parseMaps([]byte("00400000 r-xp 00000000 08:02 173521\n")); err == nil {
+		t.Fatal("expected error for invalid address range")
+	}
+}
+
+func TestGetMaps(t *testing.T) {
+	ms, err := GetMaps(int64(os.Getpid()))
+	if err != nil {
+		t.Skip(err)
+	}
+	if len(ms) == 0 {
+		t.Fatal("expected mappings")
+	}
+	for _, m := range ms {
+		if m.EndAddress <= m.StartAddress {
+			t.Fatalf("unexpected address range %+v", m)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package proc
+
+import (
+	"fmt"
+	"io/ioutil"
+	"reflect"
+	"strings"
+
+	"github.com/gyuho/linux-inspect/pkg/fileutil"
+	"github.com/gyuho/linux-inspect/schema"
+
+	humanize "github.com/dustin/go-humanize"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// GetMeminfo reads '/proc/meminfo'.
+func GetMeminfo() (Meminfo, error) {
+	return defaultFS.GetMeminfo()
+}
+
+// GetMeminfo reads '$ROOT/meminfo'.
+func (fs FS) GetMeminfo() (Meminfo, error) {
+	f, err := fileutil.OpenToRead(fs.path("meminfo"))
+	if err != nil {
+		return Meminfo{}, err
+	}
+	defer f.Close()
+
+	d, err := ioutil.ReadAll(f)
+	if err != nil {
+		return Meminfo{}, err
+	}
+	return parseMeminfo(d)
+}
+
+func parseMeminfo(d []byte) (m Meminfo, err error) {
+	if err = yaml.Unmarshal(d, &m); err != nil {
+		return Meminfo{}, err
+	}
+
+	val := reflect.ValueOf(&m).Elem()
+	for name, tp := range MeminfoSchema.ColumnsToParse {
+		if tp != schema.TypeBytes {
+			continue
+		}
+		column := schema.ToField(name)
+		fv := val.FieldByName(column)
+		if !fv.IsValid() {
+			continue
+		}
+		u, err := parseKibibytes(fv.String())
+		if err != nil {
+			return Meminfo{}, fmt.Errorf("%v in %s", err, name)
+		}
+		val.FieldByName(column + "BytesN").SetUint(u)
+		val.FieldByName(column + "ParsedBytes").SetString(humanize.Bytes(u))
+	}
+	return m, nil
+}
+
+// parseKibibytes parses '16318212 kB', where 'kB' is 1024 bytes in '/proc'.
+func parseKibibytes(s string) (uint64, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, nil
+	}
+	return humanize.ParseBytes(strings.Replace(s, "kB", "KiB", 1))
+}
@@ -0,0 +1,61 @@
+package proc
+
+import (
+	"fmt"
+	"testing"
+)
+
+const testMeminfo = `MemTotal:       16318212 kB
+MemFree:          541440 kB
+MemAvailable:   10466016 kB
+Buffers:          790936 kB
+Cached:          8829580 kB
+Active(anon):    4616664 kB
+SwapTotal:      16650236 kB
+SwapFree:       16650236 kB
+Dirty:               548 kB
+Committed_AS:   12349372 kB
+HugePages_Total:       4
+HugePages_Free:        2
+Hugepagesize:       2048 kB
+`
+
+func TestParseMeminfo(t *testing.T) {
+	m, err := parseMeminfo([]byte(testMeminfo))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.MemTotalBytesN != 16318212*1024 {
+		t.Fatalf("MemTotal expected %d, got %d", 16318212*1024, m.MemTotalBytesN)
+	}
+	if m.ActiveAnonBytesN != 4616664*1024 {
+		t.Fatalf("Active(anon) expected %d, got %d", 4616664*1024, m.ActiveAnonBytesN)
+	}
+	if m.CommittedASBytesN != 12349372*1024 {
+		t.Fatalf("Committed_AS expected %d, got %d", 12349372*1024, m.CommittedASBytesN)
+	}
+	if m.HugePagesTotal != 4 || m.HugePagesFree != 2 {
+		t.Fatalf("unexpected huge pages %d, %d", m.HugePagesTotal, m.HugePagesFree)
+	}
+	if m.HugepagesizeParsedBytes != "2.1 MB" {
+		t.Fatalf("Hugepagesize expected '2.1 MB', got %q", m.HugepagesizeParsedBytes)
+	}
+	if m.HardwareCorruptedBytesN != 0 {
+		t.Fatalf("missing field expected 0, got %d", m.HardwareCorruptedBytesN)
+	}
+
+	if _, err = parseMeminfo([]byte("MemTotal:       16318212 xB\n")); err == nil {
+		t.Fatal("expected error for malformed MemTotal")
+	}
+}
+
+func TestGetMeminfo(t *testing.T) {
+	m, err := GetMeminfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.MemTotalBytesN == 0 {
+		t.Fatalf("unexpected MemTotal %+v", m)
+	}
+	fmt.Printf("GetMeminfo: %+v\n", m)
+}
@@ -0,0 +1,95 @@
+package proc
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"github.com/gyuho/linux-inspect/pkg/fileutil"
+)
+
+// NetARP is an entry in '/proc/net/arp'.
+type NetARP struct {
+	IPAddress string
+	// HWType is the 'ARPHRD_*' hardware type (e.g. 0x1 for ethernet).
+	HWType uint64
+	// Flags is the 'ATF_*' flags (e.g. 0x2 for 'ATF_COM').
+	Flags uint64
+	// FlagsParsed is 'incomplete', 'complete' or 'permanent'.
+	FlagsParsed string
+	HWAddress   string
+	Mask        string
+	Device      string
+}
+
+// GetNetARP reads '/proc/net/arp'.
+func GetNetARP() ([]NetARP, error) {
+	return defaultFS.GetNetARP()
+}
+
+// GetNetARP reads '$ROOT/net/arp'.
+func (fs FS) GetNetARP() ([]NetARP, error) {
+	f, err := fileutil.OpenToRead(fs.path("net", "arp"))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	d, err := ioutil.ReadAll(f)
+	if err != nil {
+		return nil, err
+	}
+	return parseNetARP(d)
+}
+
+// parseNetARP parses '/proc/net/arp':
+//
+//	IP address       HW type     Flags       HW address            Mask     Device
+//	192.0.2.1        0x1         0x2         02:fc:00:00:00:05     *        eth0
+func parseNetARP(d []byte) ([]NetARP, error) {
+	var as []NetARP
+	header := true
+	scanner := bufio.NewScanner(bytes.NewReader(d))
+	for scanner.Scan() {
+		ds := strings.Fields(scanner.Text())
+		if len(ds) == 0 {
+			continue
+		}
+		if header {
+			header = false
+			if ds[0] == "IP" {
+				continue
+			}
+		}
+		if len(ds) != 6 {
+			return nil, fmt.Errorf("unexpected columns at %v", ds)
+		}
+
+		a := NetARP{
+			IPAddress: ds[0],
+			HWAddress: ds[3],
+			Mask:      ds[4],
+			Device:    ds[5],
+		}
+		var err error
+		if a.HWType, err = strconv.ParseUint(strings.TrimPrefix(ds[1], "0x"), 16, 64); err != nil {
+			return nil, err
+		}
+		if a.Flags, err = strconv.ParseUint(strings.TrimPrefix(ds[2], "0x"), 16, 64); err != nil {
+			return nil, err
+		}
+		switch {
+		case a.Flags&0x4 != 0: // ATF_PERM
+			a.FlagsParsed = "permanent"
+		case a.Flags&0x2 != 0: // ATF_COM
+			a.FlagsParsed = "complete"
+		default:
+			a.FlagsParsed = "incomplete"
+		}
+		as = append(as, a)
+	}
+	return as, scanner.Err()
+}
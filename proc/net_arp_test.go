@@ -0,0 +1,40 @@
+package proc
+
+import (
+	"fmt"
+	"testing"
+)
+
+const testNetARP = `IP address       HW type     Flags       HW address            Mask     Device
+192.0.2.1        0x1         0x2         02:fc:00:00:00:05     *        eth0
+192.0.2.7        0x1         0x0         00:00:00:00:00:00     *        eth0
+172.17.0.2       0x1         0x6         02:42:ac:11:00:02     *        docker0
+`
+
+func TestParseNetARP(t *testing.T) {
+	as, err := parseNetARP([]byte(testNetARP))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []NetARP{
+		{IPAddress: "192.0.2.1", HWType: 1, Flags: 0x2, FlagsParsed: "complete", HWAddress: "02:fc:00:00:00:05", Mask: "*", Device: "eth0"},
+		{IPAddress: "192.0.2.7", HWType: 1, Flags: 0x0, FlagsParsed: "incomplete", HWAddress: "00:00:00:00:00:00", Mask: "*", Device: "eth0"},
+		{IPAddress: "172.17.0.2", HWType: 1, Flags: 0x6, FlagsParsed: "permanent", HWAddress: "02:42:ac:11:00:02", Mask: "*", Device: "docker0"},
+	}
+	if len(as) != len(tests) {
+		t.Fatalf("expected %d entries, got %+v", len(tests), as)
+	}
+	for i := range tests {
+		if as[i] != tests[i] {
+			t.Fatalf("#%d: expected %+v, got %+v", i, tests[i], as[i])
+		}
+	}
+}
+
+func TestGetNetARP(t *testing.T) {
+	as, err := GetNetARP()
+	if err != nil {
+		t.Skip(err)
+	}
+	fmt.Printf("%+v\n", as)
+}
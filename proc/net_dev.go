@@ -38,12 +38,34 @@ const (
 
 // GetNetDev reads '/proc/net/dev'.
 func GetNetDev() (nds []NetDev, err error) {
-	var d []byte
-	d, err = readNetDev()
+	return defaultFS.GetNetDev()
+}
+
+// GetNetDev reads '$ROOT/net/dev'.
+func (fs FS) GetNetDev() (nds []NetDev, err error) {
+	d, err := readNetDev(fs.path("net", "dev"))
+	if err != nil {
+		return nil, err
+	}
+	return parseNetDev(d)
+}
+
+// GetNetDevByPID reads '/proc/$PID/net/dev', the network devices
+// in the network namespace of the PID.
+func GetNetDevByPID(pid int64) ([]NetDev, error) {
+	return defaultFS.GetNetDevByPID(pid)
+}
+
+// GetNetDevByPID reads '$ROOT/$PID/net/dev'.
+func (fs FS) GetNetDevByPID(pid int64) ([]NetDev, error) {
+	d, err := readNetDev(fs.pidPath(pid, "net", "dev"))
 	if err != nil {
 		return nil, err
 	}
+	return parseNetDev(d)
+}
 
+func parseNetDev(d []byte) (nds []NetDev, err error) {
 	header := true
 	scanner := bufio.NewScanner(bytes.NewReader(d))
 	for scanner.Scan() {
@@ -171,8 +193,8 @@ func GetNetDev() (nds []NetDev, err error) {
 	return nds, nil
 }
 
-func readNetDev() ([]byte, error) {
-	f, err := fileutil.OpenToRead("/proc/net/dev")
+func readNetDev(fpath string) ([]byte, error) {
+	f, err := fileutil.OpenToRead(fpath)
 	if err != nil {
 		return nil, err
 	}
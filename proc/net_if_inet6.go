@@ -0,0 +1,102 @@
+package proc
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"github.com/gyuho/linux-inspect/pkg/fileutil"
+)
+
+// NetIfInet6 is an IPv6 address in '/proc/net/if_inet6'.
+type NetIfInet6 struct {
+	Address string
+	// Index is the interface index.
+	Index        uint64
+	PrefixLength int
+	// Scope is the 'IPV6_ADDR_*' scope (e.g. 0x20 for link-local).
+	Scope uint64
+	// ScopeParsed is 'global', 'host', 'link', 'site' or 'compat'.
+	ScopeParsed string
+	// Flags is the 'IFA_F_*' flags (e.g. 0x80 for 'IFA_F_PERMANENT').
+	Flags     uint64
+	Interface string
+}
+
+// GetNetIfInet6 reads '/proc/net/if_inet6'.
+func GetNetIfInet6() ([]NetIfInet6, error) {
+	return defaultFS.GetNetIfInet6()
+}
+
+// GetNetIfInet6 reads '$ROOT/net/if_inet6'.
+func (fs FS) GetNetIfInet6() ([]NetIfInet6, error) {
+	f, err := fileutil.OpenToRead(fs.path("net", "if_inet6"))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	d, err := ioutil.ReadAll(f)
+	if err != nil {
+		return nil, err
+	}
+	return parseNetIfInet6(d)
+}
+
+// parseNetIfInet6 parses '/proc/net/if_inet6', where the address
+// is in network byte order hexadecimal, and the others except
+// the interface name are in hexadecimal:
+//
+//	fe8000000000000000fc00fffe000001 04 40 20 80     eth0
+func parseNetIfInet6(d []byte) ([]NetIfInet6, error) {
+	var as []NetIfInet6
+	scanner := bufio.NewScanner(bytes.NewReader(d))
+	for scanner.Scan() {
+		ds := strings.Fields(scanner.Text())
+		if len(ds) == 0 {
+			continue
+		}
+		if len(ds) != 6 {
+			return nil, fmt.Errorf("unexpected columns at %v", ds)
+		}
+
+		a := NetIfInet6{Interface: ds[5]}
+		var err error
+		if a.Address, err = parseIpv6Addr(ds[0]); err != nil {
+			return nil, err
+		}
+		if a.Index, err = strconv.ParseUint(ds[1], 16, 64); err != nil {
+			return nil, err
+		}
+		plen, err := strconv.ParseUint(ds[2], 16, 8)
+		if err != nil {
+			return nil, err
+		}
+		a.PrefixLength = int(plen)
+		if a.Scope, err = strconv.ParseUint(ds[3], 16, 64); err != nil {
+			return nil, err
+		}
+		if a.Flags, err = strconv.ParseUint(ds[4], 16, 64); err != nil {
+			return nil, err
+		}
+		switch a.Scope & 0xf0 {
+		case 0x00:
+			a.ScopeParsed = "global"
+		case 0x10:
+			a.ScopeParsed = "host"
+		case 0x20:
+			a.ScopeParsed = "link"
+		case 0x40:
+			a.ScopeParsed = "site"
+		case 0x80:
+			a.ScopeParsed = "compat"
+		default:
+			a.ScopeParsed = fmt.Sprintf("unknown scope 0x%x", a.Scope)
+		}
+		as = append(as, a)
+	}
+	return as, scanner.Err()
+}
@@ -0,0 +1,39 @@
+package proc
+
+import (
+	"fmt"
+	"testing"
+)
+
+const testNetIfInet6 = `00000000000000000000000000000001 01 80 10 80       lo
+fd000000000000000000000000000002 04 40 00 82     eth0
+fe8000000000000000fc00fffe000001 04 40 20 80     eth0
+`
+
+func TestParseNetIfInet6(t *testing.T) {
+	as, err := parseNetIfInet6([]byte(testNetIfInet6))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []NetIfInet6{
+		{Address: "0000:0000:0000:0000:0000:0000:0000:0001", Index: 1, PrefixLength: 128, Scope: 0x10, ScopeParsed: "host", Flags: 0x80, Interface: "lo"},
+		{Address: "fd00:0000:0000:0000:0000:0000:0000:0002", Index: 4, PrefixLength: 64, Scope: 0x00, ScopeParsed: "global", Flags: 0x82, Interface: "eth0"},
+		{Address: "fe80:0000:0000:0000:00fc:00ff:fe00:0001", Index: 4, PrefixLength: 64, Scope: 0x20, ScopeParsed: "link", Flags: 0x80, Interface: "eth0"},
+	}
+	if len(as) != len(tests) {
+		t.Fatalf("expected %d entries, got %+v", len(tests), as)
+	}
+	for i := range tests {
+		if as[i] != tests[i] {
+			t.Fatalf("#%d: expected %+v, got %+v", i, tests[i], as[i])
+		}
+	}
+}
+
+func TestGetNetIfInet6(t *testing.T) {
+	as, err := GetNetIfInet6()
+	if err != nil {
+		t.Skip(err)
+	}
+	fmt.Printf("%+v\n", as)
+}
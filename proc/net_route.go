@@ -0,0 +1,210 @@
+package proc
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/gyuho/linux-inspect/pkg/fileutil"
+)
+
+// NetRoute is a route in '/proc/net/route' or '/proc/net/ipv6_route'.
+type NetRoute struct {
+	Interface string
+
+	// Destination is the destination network or host.
+	Destination string
+	// PrefixLength is the destination prefix length
+	// (e.g. 24 for mask '255.255.255.0').
+	PrefixLength int
+	// Mask is the destination mask, converted from the
+	// prefix length in '/proc/net/ipv6_route'.
+	Mask string
+	// Gateway is the next hop, all zeros if directly connected.
+	Gateway string
+
+	Metric uint64
+	RefCnt uint64
+	Use    uint64
+
+	// Flags is the 'RTF_*' route flags (e.g. 0x3 for 'RTF_UP|RTF_GATEWAY').
+	Flags uint64
+	// FlagsParsed is the flags as in 'route -n' (e.g. 'UG').
+	FlagsParsed string
+}
+
+// GetNetRoute reads '/proc/net/route'.
+func GetNetRoute() ([]NetRoute, error) {
+	return defaultFS.GetNetRoute()
+}
+
+// GetNetRoute reads '$ROOT/net/route'.
+func (fs FS) GetNetRoute() ([]NetRoute, error) {
+	d, err := readNetRoute(fs.path("net", "route"))
+	if err != nil {
+		return nil, err
+	}
+	return parseNetRoute(d)
+}
+
+// GetNetIPv6Route reads '/proc/net/ipv6_route'.
+func GetNetIPv6Route() ([]NetRoute, error) {
+	return defaultFS.GetNetIPv6Route()
+}
+
+// GetNetIPv6Route reads '$ROOT/net/ipv6_route'.
+func (fs FS) GetNetIPv6Route() ([]NetRoute, error) {
+	d, err := readNetRoute(fs.path("net", "ipv6_route"))
+	if err != nil {
+		return nil, err
+	}
+	return parseNetIPv6Route(d)
+}
+
+func readNetRoute(fpath string) ([]byte, error) {
+	f, err := fileutil.OpenToRead(fpath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ioutil.ReadAll(f)
+}
+
+// parseNetRoute parses '/proc/net/route', where addresses
+// are in little endian hexadecimal (e.g. '010200C0' for '192.0.2.1'):
+//
+//	Iface Destination Gateway Flags RefCnt Use Metric Mask MTU Window IRTT
+//	eth0  00000000    010200C0 0003 0      0   0      00000000 0 0    0
+func parseNetRoute(d []byte) ([]NetRoute, error) {
+	var rs []NetRoute
+	header := true
+	scanner := bufio.NewScanner(bytes.NewReader(d))
+	for scanner.Scan() {
+		ds := strings.Fields(scanner.Text())
+		if len(ds) == 0 {
+			continue
+		}
+		if header {
+			header = false
+			if ds[0] == "Iface" {
+				continue
+			}
+		}
+		if len(ds) < 8 {
+			return nil, fmt.Errorf("not enough columns at %v", ds)
+		}
+
+		r := NetRoute{Interface: ds[0]}
+		var err error
+		if r.Destination, err = parseLittleEndianIpv4Addr(ds[1]); err != nil {
+			return nil, err
+		}
+		if r.Gateway, err = parseLittleEndianIpv4Addr(ds[2]); err != nil {
+			return nil, err
+		}
+		if r.Flags, err = strconv.ParseUint(ds[3], 16, 64); err != nil {
+			return nil, err
+		}
+		if r.RefCnt, err = strconv.ParseUint(ds[4], 10, 64); err != nil {
+			return nil, err
+		}
+		if r.Use, err = strconv.ParseUint(ds[5], 10, 64); err != nil {
+			return nil, err
+		}
+		if r.Metric, err = strconv.ParseUint(ds[6], 10, 64); err != nil {
+			return nil, err
+		}
+		if r.Mask, err = parseLittleEndianIpv4Addr(ds[7]); err != nil {
+			return nil, err
+		}
+		r.PrefixLength, _ = net.IPMask(net.ParseIP(r.Mask).To4()).Size()
+		r.FlagsParsed = parseRouteFlags(r.Flags)
+		rs = append(rs, r)
+	}
+	return rs, scanner.Err()
+}
+
+// parseNetIPv6Route parses '/proc/net/ipv6_route', where addresses
+// are in network byte order hexadecimal, and prefix lengths, metric,
+// reference count, use and flags are in hexadecimal:
+//
+//	destination prefix source prefix next-hop metric refcnt use flags interface
+func parseNetIPv6Route(d []byte) ([]NetRoute, error) {
+	var rs []NetRoute
+	scanner := bufio.NewScanner(bytes.NewReader(d))
+	for scanner.Scan() {
+		ds := strings.Fields(scanner.Text())
+		if len(ds) == 0 {
+			continue
+		}
+		if len(ds) != 10 {
+			return nil, fmt.Errorf("unexpected columns at %v", ds)
+		}
+
+		r := NetRoute{Interface: ds[9]}
+		var err error
+		if r.Destination, err = parseIpv6Addr(ds[0]); err != nil {
+			return nil, err
+		}
+		plen, err := strconv.ParseUint(ds[1], 16, 8)
+		if err != nil {
+			return nil, err
+		}
+		if plen > 128 {
+			return nil, fmt.Errorf("invalid ipv6 prefix length %d", plen)
+		}
+		r.PrefixLength = int(plen)
+		if r.Mask, err = parseIpv6Addr(fmt.Sprintf("%x", []byte(net.CIDRMask(r.PrefixLength, 128)))); err != nil {
+			return nil, err
+		}
+		if r.Gateway, err = parseIpv6Addr(ds[4]); err != nil {
+			return nil, err
+		}
+		if r.Metric, err = strconv.ParseUint(ds[5], 16, 64); err != nil {
+			return nil, err
+		}
+		if r.RefCnt, err = strconv.ParseUint(ds[6], 16, 64); err != nil {
+			return nil, err
+		}
+		if r.Use, err = strconv.ParseUint(ds[7], 16, 64); err != nil {
+			return nil, err
+		}
+		if r.Flags, err = strconv.ParseUint(ds[8], 16, 64); err != nil {
+			return nil, err
+		}
+		r.FlagsParsed = parseRouteFlags(r.Flags)
+		rs = append(rs, r)
+	}
+	return rs, scanner.Err()
+}
+
+// routeFlags are the 'RTF_*' flags in 'linux/route.h'
+// and 'linux/ipv6_route.h', as in 'route -n'.
+var routeFlags = []struct {
+	flag uint64
+	name string
+}{
+	{0x0001, "U"},     // RTF_UP
+	{0x0002, "G"},     // RTF_GATEWAY
+	{0x0004, "H"},     // RTF_HOST
+	{0x0008, "R"},     // RTF_REINSTATE
+	{0x0010, "D"},     // RTF_DYNAMIC
+	{0x0020, "M"},     // RTF_MODIFIED
+	{0x00040000, "A"}, // RTF_ADDRCONF
+	{0x01000000, "C"}, // RTF_CACHE
+	{0x0200, "!"},     // RTF_REJECT
+}
+
+func parseRouteFlags(flags uint64) string {
+	s := ""
+	for _, f := range routeFlags {
+		if flags&f.flag != 0 {
+			s += f.name
+		}
+	}
+	return s
+}
@@ -0,0 +1,103 @@
+package proc
+
+import (
+	"fmt"
+	"testing"
+)
+
+const testNetRoute = `Iface	Destination	Gateway 	Flags	RefCnt	Use	Metric	Mask		MTU	Window	IRTT
+eth0	00000000	010200C0	0003	0	0	100	00000000	0	0	0
+eth0	000200C0	00000000	0001	0	0	0	00FFFFFF	0	0	0
+docker0	000011AC	00000000	0201	0	0	0	0000FFFF	0	0	0
+`
+
+const testNetIPv6Route = `fe800000000000000000000000000000 40 00000000000000000000000000000000 00 00000000000000000000000000000000 00000100 00000002 00000000 00000001     eth0
+00000000000000000000000000000000 00 00000000000000000000000000000000 00 fe800000000000000000000000000001 00000400 00000001 0000000a 00040003     eth0
+00000000000000000000000000000001 80 00000000000000000000000000000000 00 00000000000000000000000000000000 00000000 00000002 00000000 80200001       lo
+`
+
+func TestParseNetRoute(t *testing.T) {
+	rs, err := parseNetRoute([]byte(testNetRoute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rs) != 3 {
+		t.Fatalf("expected 3 routes, got %+v", rs)
+	}
+	tests := []NetRoute{
+		{Interface: "eth0", Destination: "0.0.0.0", PrefixLength: 0, Mask: "0.0.0.0", Gateway: "192.0.2.1", Metric: 100, Flags: 0x3, FlagsParsed: "UG"},
+		{Interface: "eth0", Destination: "192.0.2.0", PrefixLength: 24, Mask: "255.255.255.0", Gateway: "0.0.0.0", Flags: 0x1, FlagsParsed: "U"},
+		{Interface: "docker0", Destination: "172.17.0.0", PrefixLength: 16, Mask: "255.255.0.0", Gateway: "0.0.0.0", Flags: 0x201, FlagsParsed: "U!"},
+	}
+	for i := range tests {
+		if rs[i] != tests[i] {
+			t.Fatalf("#%d: expected %+v, got %+v", i, tests[i], rs[i])
+		}
+	}
+
+	if _, err = parseNetRoute([]byte("eth0 00000000 010200C0 0003\n")); err == nil {
+		t.Fatal("expected error for not enough columns")
+	}
+}
+
+func TestParseNetIPv6Route(t *testing.T) {
+	rs, err := parseNetIPv6Route([]byte(testNetIPv6Route))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rs) != 3 {
+		t.Fatalf("expected 3 routes, got %+v", rs)
+	}
+	tests := []NetRoute{
+		{
+			Interface:    "eth0",
+			Destination:  "fe80:0000:0000:0000:0000:0000:0000:0000",
+			PrefixLength: 64,
+			Mask:         "ffff:ffff:ffff:ffff:0000:0000:0000:0000",
+			Gateway:      "0000:0000:0000:0000:0000:0000:0000:0000",
+			Metric:       256,
+			RefCnt:       2,
+			Flags:        0x1,
+			FlagsParsed:  "U",
+		},
+		{
+			Interface:    "eth0",
+			Destination:  "0000:0000:0000:0000:0000:0000:0000:0000",
+			PrefixLength: 0,
+			Mask:         "0000:0000:0000:0000:0000:0000:0000:0000",
+			Gateway:      "fe80:0000:0000:0000:0000:0000:0000:0001",
+			Metric:       1024,
+			RefCnt:       1,
+			Use:          10,
+			Flags:        0x40003,
+			FlagsParsed:  "UGA",
+		},
+		{
+			Interface:    "lo",
+			Destination:  "0000:0000:0000:0000:0000:0000:0000:0001",
+			PrefixLength: 128,
+			Mask:         "ffff:ffff:ffff:ffff:ffff:ffff:ffff:ffff",
+			Gateway:      "0000:0000:0000:0000:0000:0000:0000:0000",
+			RefCnt:       2,
+			Flags:        0x80200001,
+			FlagsParsed:  "U",
+		},
+	}
+	for i := range tests {
+		if rs[i] != tests[i] {
+			t.Fatalf("#%d: expected %+v, got %+v", i, tests[i], rs[i])
+		}
+	}
+
+	if _, err = parseNetIPv6Route([]byte("fe800000000000000000000000000000 81 00000000000000000000000000000000 00 00000000000000000000000000000000 00000100 00000002 00000000 00000001 eth0\n")); err == nil {
+		t.Fatal("expected error for prefix length 129")
+	}
+}
+
+func TestGetNetRoute(t *testing.T) {
+	rs, err := GetNetRoute()
+	if err != nil {
+		t.Skip(err)
+	}
+	fmt.Printf("%+v\n", rs)
+}
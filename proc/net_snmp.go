@@ -0,0 +1,271 @@
+package proc
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/gyuho/linux-inspect/pkg/fileutil"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// NetSnmp is '/proc/net/snmp' in Linux.
+type NetSnmp struct {
+	IP      NetSnmpIP
+	ICMP    NetSnmpICMP
+	TCP     NetSnmpTCP
+	UDP     NetSnmpUDP
+	UDPLite NetSnmpUDP
+}
+
+// Netstat is '/proc/net/netstat' in Linux.
+type Netstat struct {
+	TCPExt NetstatTCPExt
+	IPExt  NetstatIPExt
+}
+
+// GetNetSnmp reads '/proc/net/snmp'.
+func GetNetSnmp() (NetSnmp, error) {
+	return defaultFS.GetNetSnmp()
+}
+
+// GetNetSnmp reads '$ROOT/net/snmp'.
+func (fs FS) GetNetSnmp() (NetSnmp, error) {
+	d, err := readNetCounters(fs.path("net", "snmp"))
+	if err != nil {
+		return NetSnmp{}, err
+	}
+	return parseNetSnmp(d)
+}
+
+// GetNetSnmp6 reads '/proc/net/snmp6'.
+func GetNetSnmp6() (NetSnmp6, error) {
+	return defaultFS.GetNetSnmp6()
+}
+
+// GetNetSnmp6 reads '$ROOT/net/snmp6'.
+func (fs FS) GetNetSnmp6() (NetSnmp6, error) {
+	d, err := readNetCounters(fs.path("net", "snmp6"))
+	if err != nil {
+		return NetSnmp6{}, err
+	}
+	return parseNetSnmp6(d)
+}
+
+// GetNetstat reads '/proc/net/netstat'.
+func GetNetstat() (Netstat, error) {
+	return defaultFS.GetNetstat()
+}
+
+// GetNetstat reads '$ROOT/net/netstat'.
+func (fs FS) GetNetstat() (Netstat, error) {
+	d, err := readNetCounters(fs.path("net", "netstat"))
+	if err != nil {
+		return Netstat{}, err
+	}
+	return parseNetstat(d)
+}
+
+// GetNetCounters reads all counters in '/proc/net/snmp',
+// '/proc/net/snmp6' and '/proc/net/netstat', named as in 'nstat'
+// (e.g. 'TcpRetransSegs', 'Udp6RcvbufErrors', 'TcpExtListenOverflows').
+// Negative values (e.g. 'TcpMaxConn' -1) are skipped.
+func GetNetCounters() (map[string]uint64, error) {
+	return defaultFS.GetNetCounters()
+}
+
+// GetNetCounters reads all counters in '$ROOT/net/snmp',
+// '$ROOT/net/snmp6' and '$ROOT/net/netstat'.
+func (fs FS) GetNetCounters() (map[string]uint64, error) {
+	cs := make(map[string]uint64)
+	for _, name := range []string{"snmp", "netstat"} {
+		d, err := readNetCounters(fs.path("net", name))
+		if err != nil {
+			return nil, err
+		}
+		sections, err := parseNetSections(d)
+		if err != nil {
+			return nil, err
+		}
+		for _, sc := range sections {
+			for i := range sc.keys {
+				if v, err := strconv.ParseUint(sc.values[i], 10, 64); err == nil {
+					cs[sc.name+sc.keys[i]] = v
+				}
+			}
+		}
+	}
+
+	// '/proc/net/snmp6' does not exist if IPv6 is disabled
+	d, err := readNetCounters(fs.path("net", "snmp6"))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if err == nil {
+		keys, values, err := parseNetFlat(d)
+		if err != nil {
+			return nil, err
+		}
+		for i := range keys {
+			if v, err := strconv.ParseUint(values[i], 10, 64); err == nil {
+				cs[keys[i]] = v
+			}
+		}
+	}
+	return cs, nil
+}
+
+func readNetCounters(fpath string) ([]byte, error) {
+	f, err := fileutil.OpenToRead(fpath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ioutil.ReadAll(f)
+}
+
+func parseNetSnmp(d []byte) (NetSnmp, error) {
+	sections, err := parseNetSections(d)
+	if err != nil {
+		return NetSnmp{}, err
+	}
+	var ns NetSnmp
+	for _, sc := range sections {
+		var v interface{}
+		switch sc.name {
+		case "Ip":
+			v = &ns.IP
+		case "Icmp":
+			v = &ns.ICMP
+		case "Tcp":
+			v = &ns.TCP
+		case "Udp":
+			v = &ns.UDP
+		case "UdpLite":
+			v = &ns.UDPLite
+		default:
+			// e.g. 'IcmpMsg'
+			continue
+		}
+		if err = yaml.Unmarshal(sc.yaml(), v); err != nil {
+			return NetSnmp{}, err
+		}
+	}
+	return ns, nil
+}
+
+func parseNetstat(d []byte) (Netstat, error) {
+	sections, err := parseNetSections(d)
+	if err != nil {
+		return Netstat{}, err
+	}
+	var ns Netstat
+	for _, sc := range sections {
+		var v interface{}
+		switch sc.name {
+		case "TcpExt":
+			v = &ns.TCPExt
+		case "IpExt":
+			v = &ns.IPExt
+		default:
+			// e.g. 'MPTcpExt'
+			continue
+		}
+		if err = yaml.Unmarshal(sc.yaml(), v); err != nil {
+			return Netstat{}, err
+		}
+	}
+	return ns, nil
+}
+
+func parseNetSnmp6(d []byte) (NetSnmp6, error) {
+	keys, values, err := parseNetFlat(d)
+	if err != nil {
+		return NetSnmp6{}, err
+	}
+	sc := netSection{keys: keys, values: values}
+	var ns NetSnmp6
+	if err = yaml.Unmarshal(sc.yaml(), &ns); err != nil {
+		return NetSnmp6{}, err
+	}
+	return ns, nil
+}
+
+// netSection is a section in '/proc/net/snmp' or '/proc/net/netstat'.
+type netSection struct {
+	name   string
+	keys   []string
+	values []string
+}
+
+// yaml converts to 'key: value' lines.
+func (sc netSection) yaml() []byte {
+	buf := new(bytes.Buffer)
+	for i := range sc.keys {
+		buf.WriteString(sc.keys[i] + ": " + sc.values[i] + "\n")
+	}
+	return buf.Bytes()
+}
+
+// parseNetSections parses '/proc/net/snmp' and '/proc/net/netstat',
+// where each section is a line of counter names followed by a line
+// of values (e.g. 'Tcp: RtoAlgorithm RtoMin ...' and 'Tcp: 1 200 ...').
+func parseNetSections(d []byte) ([]netSection, error) {
+	var (
+		sections []netSection
+		header   []string
+	)
+	scanner := bufio.NewScanner(bytes.NewReader(d))
+	for scanner.Scan() {
+		fs := strings.Fields(scanner.Text())
+		if len(fs) == 0 {
+			continue
+		}
+		if !strings.HasSuffix(fs[0], ":") {
+			return nil, fmt.Errorf("unknown section line %q", scanner.Text())
+		}
+		if header == nil {
+			header = fs
+			continue
+		}
+		if header[0] != fs[0] || len(header) != len(fs) {
+			return nil, fmt.Errorf("section %q has %d names, but %q has %d values", header[0], len(header)-1, fs[0], len(fs)-1)
+		}
+		sections = append(sections, netSection{
+			name:   strings.TrimSuffix(fs[0], ":"),
+			keys:   header[1:],
+			values: fs[1:],
+		})
+		header = nil
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if header != nil {
+		return nil, fmt.Errorf("section %q has no values", header[0])
+	}
+	return sections, nil
+}
+
+// parseNetFlat parses '/proc/net/snmp6', with a counter per line
+// (e.g. 'Ip6InReceives 123').
+func parseNetFlat(d []byte) (keys, values []string, err error) {
+	scanner := bufio.NewScanner(bytes.NewReader(d))
+	for scanner.Scan() {
+		fs := strings.Fields(scanner.Text())
+		if len(fs) == 0 {
+			continue
+		}
+		if len(fs) != 2 {
+			return nil, nil, fmt.Errorf("unknown counter line %q", scanner.Text())
+		}
+		keys = append(keys, fs[0])
+		values = append(values, fs[1])
+	}
+	return keys, values, scanner.Err()
+}
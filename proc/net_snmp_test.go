@@ -0,0 +1,150 @@
+package proc
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const testNetSnmp = `Ip: Forwarding DefaultTTL InReceives InHdrErrors InAddrErrors ForwDatagrams InUnknownProtos InDiscards InDelivers OutRequests OutDiscards OutNoRoutes ReasmTimeout ReasmReqds ReasmOKs ReasmFails FragOKs FragFails FragCreates
+Ip: 1 64 2510187 0 0 0 0 0 2509914 2398310 2 40 0 0 0 0 0 0 0
+Icmp: InMsgs InErrors InCsumErrors InDestUnreachs InTimeExcds InParmProbs InSrcQuenchs InRedirects InEchos InEchoReps InTimestamps InTimestampReps InAddrMasks InAddrMaskReps OutMsgs OutErrors OutDestUnreachs OutTimeExcds OutParmProbs OutSrcQuenchs OutRedirects OutEchos OutEchoReps OutTimestamps OutTimestampReps OutAddrMasks OutAddrMaskReps
+Icmp: 45 0 0 41 0 0 0 0 4 0 0 0 0 0 46 0 42 0 0 0 0 0 4 0 0 0 0
+IcmpMsg: InType3 InType8 OutType0 OutType3
+IcmpMsg: 41 4 4 42
+Tcp: RtoAlgorithm RtoMin RtoMax MaxConn ActiveOpens PassiveOpens AttemptFails EstabResets CurrEstab InSegs OutSegs RetransSegs InErrs OutRsts InCsumErrors
+Tcp: 1 200 120000 -1 34813 12014 1180 1316 41 2442613 2513853 8745 7 3047 0
+Udp: InDatagrams NoPorts InErrors OutDatagrams RcvbufErrors SndbufErrors InCsumErrors IgnoredMulti
+Udp: 63316 42 1024 63601 1024 0 0 3
+UdpLite: InDatagrams NoPorts InErrors OutDatagrams RcvbufErrors SndbufErrors InCsumErrors IgnoredMulti
+UdpLite: 0 0 0 0 0 0 0 0
+`
+
+const testNetstat = `TcpExt: SyncookiesSent SyncookiesRecv SyncookiesFailed EmbryonicRsts PruneCalled RcvPruned OfoPruned OutOfWindowIcmps LockDroppedIcmps ArpFilter TW ListenOverflows ListenDrops TCPTimeouts TCPFastRetrans TCPSynRetrans
+TcpExt: 12 3 0 0 5 0 0 0 0 0 17890 214 220 6731 1502 388
+IpExt: InNoRoutes InTruncatedPkts InMcastPkts OutMcastPkts InBcastPkts OutBcastPkts InOctets OutOctets InCsumErrors
+IpExt: 0 0 120 38 0 0 1843009821 512030477 0
+MPTcpExt: MPCapableSYNRX MPCapableSYNTX
+MPTcpExt: 0 0
+`
+
+const testNetSnmp6 = `Ip6InReceives                   	1920
+Ip6InHdrErrors                  	0
+Ip6InDelivers                   	1880
+Ip6OutRequests                  	1904
+Icmp6InMsgs                     	12
+Icmp6InType135                  	6
+Udp6InDatagrams                 	410
+Udp6NoPorts                     	2
+Udp6RcvbufErrors                	17
+`
+
+func TestParseNetSnmp(t *testing.T) {
+	ns, err := parseNetSnmp([]byte(testNetSnmp))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ns.IP.InReceives != 2510187 || ns.IP.OutNoRoutes != 40 || ns.IP.DefaultTTL != 64 {
+		t.Fatalf("unexpected Ip %+v", ns.IP)
+	}
+	if ns.ICMP.InMsgs != 45 || ns.ICMP.OutDestUnreachs != 42 {
+		t.Fatalf("unexpected Icmp %+v", ns.ICMP)
+	}
+	if ns.TCP.MaxConn != -1 || ns.TCP.RetransSegs != 8745 || ns.TCP.InErrs != 7 || ns.TCP.CurrEstab != 41 {
+		t.Fatalf("unexpected Tcp %+v", ns.TCP)
+	}
+	if ns.UDP.RcvbufErrors != 1024 || ns.UDP.InErrors != 1024 || ns.UDP.NoPorts != 42 {
+		t.Fatalf("unexpected Udp %+v", ns.UDP)
+	}
+	if ns.UDPLite.InDatagrams != 0 {
+		t.Fatalf("unexpected UdpLite %+v", ns.UDPLite)
+	}
+}
+
+func TestParseNetstat(t *testing.T) {
+	ns, err := parseNetstat([]byte(testNetstat))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ns.TCPExt.ListenOverflows != 214 || ns.TCPExt.ListenDrops != 220 || ns.TCPExt.TCPTimeouts != 6731 || ns.TCPExt.TW != 17890 {
+		t.Fatalf("unexpected TcpExt %+v", ns.TCPExt)
+	}
+	if ns.IPExt.InOctets != 1843009821 || ns.IPExt.InMcastPkts != 120 {
+		t.Fatalf("unexpected IpExt %+v", ns.IPExt)
+	}
+}
+
+func TestParseNetSnmp6(t *testing.T) {
+	ns, err := parseNetSnmp6([]byte(testNetSnmp6))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ns.Ip6InReceives != 1920 || ns.Icmp6InMsgs != 12 || ns.Udp6RcvbufErrors != 17 {
+		t.Fatalf("unexpected snmp6 %+v", ns)
+	}
+}
+
+func TestParseNetSectionsError(t *testing.T) {
+	for _, txt := range []string{
+		"Tcp: RtoAlgorithm RtoMin\nTcp: 1\n",
+		"Tcp: RtoAlgorithm RtoMin\nUdp: 1 2\n",
+		"Tcp: RtoAlgorithm RtoMin\n",
+	} {
+		if _, err := parseNetSections([]byte(txt)); err == nil {
+			t.Fatalf("expected error for %q", txt)
+		}
+	}
+}
+
+func TestFSGetNetCounters(t *testing.T) {
+	root, err := ioutil.TempDir(os.TempDir(), "proc-net-snmp-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	if err = os.MkdirAll(filepath.Join(root, "net"), 0777); err != nil {
+		t.Fatal(err)
+	}
+	for name, txt := range map[string]string{"snmp": testNetSnmp, "netstat": testNetstat} {
+		if err = ioutil.WriteFile(filepath.Join(root, "net", name), []byte(txt), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	fs, err := NewFS(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// no 'snmp6', as with IPv6 disabled
+	cs, err := fs.GetNetCounters()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cs["TcpRetransSegs"] != 8745 || cs["UdpRcvbufErrors"] != 1024 || cs["TcpExtListenOverflows"] != 214 || cs["IcmpMsgInType3"] != 41 {
+		t.Fatalf("unexpected counters %v", cs)
+	}
+	if _, ok := cs["TcpMaxConn"]; ok {
+		t.Fatalf("expected negative 'TcpMaxConn' to be skipped, got %v", cs)
+	}
+
+	if err = ioutil.WriteFile(filepath.Join(root, "net", "snmp6"), []byte(testNetSnmp6), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if cs, err = fs.GetNetCounters(); err != nil {
+		t.Fatal(err)
+	}
+	if cs["Udp6RcvbufErrors"] != 17 || cs["Icmp6InType135"] != 6 {
+		t.Fatalf("unexpected counters %v", cs)
+	}
+}
+
+func TestGetNetSnmp(t *testing.T) {
+	ns, err := GetNetSnmp()
+	if err != nil {
+		t.Skip(err)
+	}
+	fmt.Printf("%+v\n", ns.TCP)
+}
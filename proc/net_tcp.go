@@ -13,28 +13,41 @@ import (
 )
 
 // GetNetTCPByPID reads '/proc/$PID/net/tcp(6)' data.
+// It also reads 'udp(6)' and 'raw(6)', which share the same format.
 func GetNetTCPByPID(pid int64, tp TransportProtocol) ([]NetTCP, error) {
-	d, err := readNetTCP(pid, tp)
-	if err != nil {
-		return nil, err
-	}
+	return defaultFS.GetNetTCPByPID(pid, tp)
+}
 
+// GetNetTCPByPID reads '$ROOT/$PID/net/tcp(6)', 'udp(6)', 'raw(6)' data.
+func (fs FS) GetNetTCPByPID(pid int64, tp TransportProtocol) ([]NetTCP, error) {
 	var ipParse func(string) (string, int64, error)
 	switch tp {
-	case TypeTCP:
+	case TypeTCP, TypeUDP, TypeRaw:
 		ipParse = parseLittleEndianIpv4
-	case TypeTCP6:
+	case TypeTCP6, TypeUDP6, TypeRaw6:
 		ipParse = parseLittleEndianIpv6
+	default:
+		return nil, fmt.Errorf("%q is not an inet protocol (use GetNetUnixByPID)", tp)
+	}
+
+	d, err := fs.readNetTCP(pid, tp)
+	if err != nil {
+		return nil, err
 	}
 	return parseNetTCP(d, ipParse, tp.String())
 }
 
-// TransportProtocol is tcp, tcp6.
+// TransportProtocol is tcp, tcp6, udp, udp6, raw, raw6, unix.
 type TransportProtocol int
 
 const (
 	TypeTCP TransportProtocol = iota
 	TypeTCP6
+	TypeUDP
+	TypeUDP6
+	TypeRaw
+	TypeRaw6
+	TypeUnix
 )
 
 func (tp TransportProtocol) String() string {
@@ -43,6 +56,16 @@ func (tp TransportProtocol) String() string {
 		return "tcp"
 	case TypeTCP6:
 		return "tcp6"
+	case TypeUDP:
+		return "udp"
+	case TypeUDP6:
+		return "udp6"
+	case TypeRaw:
+		return "raw"
+	case TypeRaw6:
+		return "raw6"
+	case TypeUnix:
+		return "unix"
 	default:
 		panic(fmt.Errorf("unknown transport protocol %d", tp))
 	}
@@ -66,6 +89,7 @@ const (
 var (
 	// RPC_SHOW_SOCK
 	// https://github.com/torvalds/linux/blob/master/include/trace/events/sunrpc.h
+	// UDP and raw sockets reuse TCP states ('07' is unconnected).
 	netTCPStatus = map[string]string{
 		"01": "ESTABLISHED",
 		"02": "SYN_SENT",
@@ -198,9 +222,8 @@ func parseNetTCP(d []byte, ipParse func(string) (string, int64, error), ipType s
 	return nss, nil
 }
 
-func readNetTCP(pid int64, tp TransportProtocol) ([]byte, error) {
-	fpath := fmt.Sprintf("/proc/%d/net/%s", pid, tp.String())
-	f, err := fileutil.OpenToRead(fpath)
+func (fs FS) readNetTCP(pid int64, tp TransportProtocol) ([]byte, error) {
+	f, err := fileutil.OpenToRead(fs.pidPath(pid, "net", tp.String()))
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,113 @@
+package proc
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// GetNetUnixByPID reads '/proc/$PID/net/unix' data.
+func GetNetUnixByPID(pid int64) ([]NetUnix, error) {
+	return defaultFS.GetNetUnixByPID(pid)
+}
+
+// GetNetUnixByPID reads '$ROOT/$PID/net/unix' data.
+func (fs FS) GetNetUnixByPID(pid int64) ([]NetUnix, error) {
+	d, err := fs.readNetTCP(pid, TypeUnix)
+	if err != nil {
+		return nil, err
+	}
+	return parseNetUnix(d)
+}
+
+type netUnixColumnIndex int
+
+const (
+	net_unix_idx_num netUnixColumnIndex = iota
+	net_unix_idx_ref_count
+	net_unix_idx_protocol
+	net_unix_idx_flags
+	net_unix_idx_type
+	net_unix_idx_st
+	net_unix_idx_inode
+	net_unix_idx_path
+)
+
+// __SO_ACCEPTCON flag of listening sockets
+// https://github.com/torvalds/linux/blob/master/include/uapi/linux/net.h
+const netUnixFlagAcceptCon = 1 << 16
+
+var (
+	// https://github.com/torvalds/linux/blob/master/include/linux/net.h
+	netUnixType = map[string]string{
+		"0001": "STREAM",
+		"0002": "DGRAM",
+		"0005": "SEQPACKET",
+	}
+
+	// https://github.com/torvalds/linux/blob/master/include/uapi/linux/net.h
+	netUnixStatus = map[string]string{
+		"01": "UNCONNECTED",
+		"02": "CONNECTING",
+		"03": "CONNECTED",
+		"04": "DISCONNECTING",
+	}
+)
+
+func parseNetUnix(d []byte) ([]NetUnix, error) {
+	var nus []NetUnix
+
+	first := true
+	scanner := bufio.NewScanner(bytes.NewReader(d))
+	for scanner.Scan() {
+		txt := scanner.Text()
+		if len(txt) == 0 {
+			continue
+		}
+
+		fs := strings.Fields(txt)
+		if first {
+			if len(fs) == 0 || fs[0] != "Num" { // header
+				return nil, fmt.Errorf("first line must be columns but got = %#q", fs)
+			}
+			first = false
+			continue
+		}
+		if len(fs) < int(net_unix_idx_inode+1) {
+			return nil, fmt.Errorf("not enough columns at %v", fs)
+		}
+
+		nu := NetUnix{
+			Num:      strings.TrimSuffix(fs[net_unix_idx_num], ":"),
+			RefCount: fs[net_unix_idx_ref_count],
+			Protocol: fs[net_unix_idx_protocol],
+			Flags:    fs[net_unix_idx_flags],
+			Type:     fs[net_unix_idx_type],
+			St:       fs[net_unix_idx_st],
+			Inode:    fs[net_unix_idx_inode],
+		}
+		if len(fs) > int(net_unix_idx_path) {
+			// path may contain spaces
+			nu.Path = strings.Join(fs[net_unix_idx_path:], " ")
+		}
+
+		nu.TypeParsedStatus = netUnixType[nu.Type]
+		nu.StParsedStatus = netUnixStatus[nu.St]
+
+		flags, err := strconv.ParseUint(nu.Flags, 16, 64)
+		if err != nil {
+			return nil, err
+		}
+		if flags&netUnixFlagAcceptCon != 0 {
+			nu.StParsedStatus = "LISTEN"
+		}
+
+		nus = append(nus, nu)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return nus, nil
+}
@@ -0,0 +1,53 @@
+package proc
+
+import (
+	"fmt"
+	"os"
+	"testing"
+)
+
+const testNetUnix = `Num       RefCount Protocol Flags    Type St Inode Path
+ffff8800b8a45800: 00000002 00000000 00010000 0001 01 15821 /run/systemd/private
+ffff8800b8a46000: 00000003 00000000 00000000 0001 03 18857
+ffff8800b8a46800: 00000002 00000000 00000000 0002 01 11027 @/org/kernel/udev/udevd
+`
+
+func TestParseNetUnix(t *testing.T) {
+	nus, err := parseNetUnix([]byte(testNetUnix))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(nus) != 3 {
+		t.Fatalf("expected 3 entries, got %+v", nus)
+	}
+	if nus[0].StParsedStatus != "LISTEN" || nus[0].TypeParsedStatus != "STREAM" || nus[0].Path != "/run/systemd/private" {
+		t.Fatalf("unexpected entry %+v", nus[0])
+	}
+	if nus[1].StParsedStatus != "CONNECTED" || nus[1].Inode != "18857" || nus[1].Path != "" {
+		t.Fatalf("unexpected entry %+v", nus[1])
+	}
+	if nus[2].TypeParsedStatus != "DGRAM" || nus[2].Path != "@/org/kernel/udev/udevd" {
+		t.Fatalf("unexpected entry %+v", nus[2])
+	}
+}
+
+func TestGetNetUnixByPID(t *testing.T) {
+	nus, err := GetNetUnixByPID(int64(os.Getpid()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fmt.Printf("GetNetUnixByPID: %d entries\n", len(nus))
+
+	for _, tp := range []TransportProtocol{TypeUDP, TypeUDP6, TypeRaw, TypeRaw6} {
+		ns, err := GetNetTCPByPID(int64(os.Getpid()), tp)
+		if err != nil {
+			// e.g. IPv6 disabled
+			fmt.Printf("GetNetTCPByPID %s: %v\n", tp, err)
+			continue
+		}
+		fmt.Printf("GetNetTCPByPID %s: %+v\n", tp, ns)
+	}
+	if _, err = GetNetTCPByPID(int64(os.Getpid()), TypeUnix); err == nil {
+		t.Fatal("expected error for unix protocol")
+	}
+}
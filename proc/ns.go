@@ -0,0 +1,115 @@
+package proc
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Namespace types in '/proc/$PID/ns'.
+const (
+	NamespaceCgroup = "cgroup"
+	NamespaceIPC    = "ipc"
+	NamespaceMnt    = "mnt"
+	NamespaceNet    = "net"
+	NamespacePID    = "pid"
+	NamespaceUser   = "user"
+	NamespaceUTS    = "uts"
+)
+
+// NamespaceTypes lists the namespace types in '/proc/$PID/ns'.
+var NamespaceTypes = []string{
+	NamespaceCgroup,
+	NamespaceIPC,
+	NamespaceMnt,
+	NamespaceNet,
+	NamespacePID,
+	NamespaceUser,
+	NamespaceUTS,
+}
+
+// Namespaces is the inode IDs of '/proc/$PID/ns/*' links.
+// Two processes are in the same namespace if the inode IDs match.
+// The inode ID is 0 if the namespace type is not supported by
+// the kernel (e.g. 'cgroup' before Linux 4.6).
+// Reference http://man7.org/linux/man-pages/man7/namespaces.7.html.
+type Namespaces struct {
+	Cgroup uint64
+	IPC    uint64
+	Mnt    uint64
+	Net    uint64
+	PID    uint64
+	User   uint64
+	UTS    uint64
+}
+
+// Get returns the inode ID of the namespace type (e.g. 'net').
+func (ns Namespaces) Get(tp string) uint64 {
+	switch tp {
+	case NamespaceCgroup:
+		return ns.Cgroup
+	case NamespaceIPC:
+		return ns.IPC
+	case NamespaceMnt:
+		return ns.Mnt
+	case NamespaceNet:
+		return ns.Net
+	case NamespacePID:
+		return ns.PID
+	case NamespaceUser:
+		return ns.User
+	case NamespaceUTS:
+		return ns.UTS
+	}
+	return 0
+}
+
+// GetNamespaces reads the '/proc/$PID/ns/*' links, such as
+// 'net:[4026531993]'. Reading other users' processes needs
+// root permission.
+func GetNamespaces(pid int64) (Namespaces, error) {
+	return defaultFS.GetNamespaces(pid)
+}
+
+// GetNamespaces reads the '$ROOT/$PID/ns/*' links.
+func (fs FS) GetNamespaces(pid int64) (Namespaces, error) {
+	var ns Namespaces
+	for _, f := range []struct {
+		tp    string
+		inode *uint64
+	}{
+		{NamespaceCgroup, &ns.Cgroup},
+		{NamespaceIPC, &ns.IPC},
+		{NamespaceMnt, &ns.Mnt},
+		{NamespaceNet, &ns.Net},
+		{NamespacePID, &ns.PID},
+		{NamespaceUser, &ns.User},
+		{NamespaceUTS, &ns.UTS},
+	} {
+		sym, err := os.Readlink(fs.pidPath(pid, "ns", f.tp))
+		if os.IsNotExist(err) {
+			// not supported by the kernel, or process has exited
+			if _, serr := os.Stat(fs.pidPath(pid, "ns")); serr != nil {
+				return Namespaces{}, serr
+			}
+			continue
+		}
+		if err != nil {
+			return Namespaces{}, err
+		}
+		if *f.inode, err = parseNamespaceLink(f.tp, sym); err != nil {
+			return Namespaces{}, err
+		}
+	}
+	return ns, nil
+}
+
+// parseNamespaceLink returns '4026531993' from 'net:[4026531993]'.
+func parseNamespaceLink(tp, s string) (uint64, error) {
+	prefix := tp + ":["
+	if !strings.HasPrefix(s, prefix) || !strings.HasSuffix(s, "]") {
+		return 0, fmt.Errorf("unknown %q namespace link %q", tp, s)
+	}
+	return strconv.ParseUint(s[len(prefix):len(s)-1], 10, 64)
+}
@@ -0,0 +1,78 @@
+package proc
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseNamespaceLink(t *testing.T) {
+	inode, err := parseNamespaceLink(NamespaceNet, "net:[4026531993]")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inode != 4026531993 {
+		t.Fatalf("expected 4026531993, got %d", inode)
+	}
+	for _, s := range []string{"pid:[4026531993]", "net:4026531993", "net:[x]"} {
+		if _, err = parseNamespaceLink(NamespaceNet, s); err == nil {
+			t.Fatalf("expected error for %q", s)
+		}
+	}
+}
+
+func TestFSGetNamespaces(t *testing.T) {
+	root, err := ioutil.TempDir(os.TempDir(), "proc-ns-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	if err = os.MkdirAll(filepath.Join(root, "42", "ns"), 0777); err != nil {
+		t.Fatal(err)
+	}
+	// no 'cgroup' namespace
+	for tp, target := range map[string]string{
+		"ipc":  "ipc:[4026531839]",
+		"mnt":  "mnt:[4026531840]",
+		"net":  "net:[4026531993]",
+		"pid":  "pid:[4026531836]",
+		"user": "user:[4026531837]",
+		"uts":  "uts:[4026531838]",
+	} {
+		if err = os.Symlink(target, filepath.Join(root, "42", "ns", tp)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	fs, err := NewFS(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ns, err := fs.GetNamespaces(42)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := Namespaces{IPC: 4026531839, Mnt: 4026531840, Net: 4026531993, PID: 4026531836, User: 4026531837, UTS: 4026531838}
+	if ns != expected {
+		t.Fatalf("expected %+v, got %+v", expected, ns)
+	}
+	if ns.Get(NamespaceNet) != 4026531993 || ns.Get(NamespaceCgroup) != 0 {
+		t.Fatalf("unexpected Get %+v", ns)
+	}
+
+	if _, err = fs.GetNamespaces(43); !os.IsNotExist(err) {
+		t.Fatalf("expected not exist error, got %v", err)
+	}
+}
+
+func TestGetNamespaces(t *testing.T) {
+	ns, err := GetNamespaces(int64(os.Getpid()))
+	if err != nil {
+		t.Skip(err)
+	}
+	if ns.Net == 0 || ns.Mnt == 0 {
+		t.Fatalf("unexpected %+v", ns)
+	}
+}
@@ -14,41 +14,54 @@ func parseLittleEndianIpv4(s string) (string, int64, error) {
 	if len(arr) != 2 {
 		return "", 0, fmt.Errorf("cannot parse ipv4 %s", s)
 	}
-	if len(arr[0]) != 8 {
-		return "", 0, fmt.Errorf("cannot parse ipv4 ip %s", arr[0])
-	}
 	if len(arr[1]) != 4 {
 		return "", 0, fmt.Errorf("cannot parse ipv4 port %s", arr[1])
 	}
 
-	d0, err := strconv.ParseInt(arr[0][6:8], 16, 32)
+	ip, err := parseLittleEndianIpv4Addr(arr[0])
 	if err != nil {
 		return "", 0, err
 	}
-	d1, err := strconv.ParseInt(arr[0][4:6], 16, 32)
+
+	port, err := strconv.ParseInt(arr[1], 16, 32)
 	if err != nil {
 		return "", 0, err
 	}
-	d2, err := strconv.ParseInt(arr[0][2:4], 16, 32)
+	return ip, port, nil
+}
+
+// parseLittleEndianIpv4Addr parses hexadecimal ipv4 IP addresses
+// without port. For example, it converts '0101007F' into '127.0.1.1'.
+// It assumes that the system has little endian order.
+func parseLittleEndianIpv4Addr(s string) (string, error) {
+	if len(s) != 8 {
+		return "", fmt.Errorf("cannot parse ipv4 ip %s", s)
+	}
+
+	d0, err := strconv.ParseInt(s[6:8], 16, 32)
 	if err != nil {
-		return "", 0, err
+		return "", err
 	}
-	d3, err := strconv.ParseInt(arr[0][0:2], 16, 32)
+	d1, err := strconv.ParseInt(s[4:6], 16, 32)
 	if err != nil {
-		return "", 0, err
+		return "", err
 	}
-	ip := fmt.Sprintf("%d.%d.%d.%d", d0, d1, d2, d3)
-
-	port, err := strconv.ParseInt(arr[1], 16, 32)
+	d2, err := strconv.ParseInt(s[2:4], 16, 32)
 	if err != nil {
-		return "", 0, err
+		return "", err
 	}
-	return ip, port, nil
+	d3, err := strconv.ParseInt(s[0:2], 16, 32)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%d.%d.%d.%d", d0, d1, d2, d3), nil
 }
 
 // parseLittleEndianIpv6 parses hexadecimal ipv6 IP addresses.
 // For example, it converts '4506012691A700C165EB1DE1F912918C:8BDA'
-// into string. It assumes that the system has little endian order.
+// into string. The kernel prints the address as four 32-bit words,
+// each in host order, so it assumes that the system has little
+// endian order and reverses each word.
 func parseLittleEndianIpv6(s string) (string, int64, error) {
 	arr := strings.Split(s, ":")
 	if len(arr) != 2 {
@@ -61,14 +74,17 @@ func parseLittleEndianIpv6(s string) (string, int64, error) {
 		return "", 0, fmt.Errorf("cannot parse ipv6 port %s", arr[1])
 	}
 
-	// 32 characters, reverse by 2 characters
-	ip := ""
-	for i := 32; i > 0; i -= 2 {
-		ip += fmt.Sprintf("%s", arr[0][i-2:i])
-		if (len(ip)+1)%5 == 0 && len(ip) != 39 {
-			ip += ":"
+	// 4 words of 8 characters, reverse each word by 2 characters
+	reversed := ""
+	for w := 8; w <= 32; w += 8 {
+		for i := w; i > w-8; i -= 2 {
+			reversed += arr[0][i-2 : i]
 		}
 	}
+	ip, err := parseIpv6Addr(reversed)
+	if err != nil {
+		return "", 0, err
+	}
 
 	port, err := strconv.ParseInt(arr[1], 16, 32)
 	if err != nil {
@@ -76,3 +92,29 @@ func parseLittleEndianIpv6(s string) (string, int64, error) {
 	}
 	return ip, port, nil
 }
+
+// parseIpv6Addr parses hexadecimal ipv6 IP addresses in network
+// byte order, without port, as in '/proc/net/ipv6_route' and
+// '/proc/net/if_inet6'. For example, it converts
+// 'fe800000000000000000000000000001' into 'fe80:0000:...:0001'.
+func parseIpv6Addr(s string) (string, error) {
+	if len(s) != 32 {
+		return "", fmt.Errorf("cannot parse ipv6 ip %s", s)
+	}
+	if _, err := strconv.ParseUint(s[:16], 16, 64); err != nil {
+		return "", err
+	}
+	if _, err := strconv.ParseUint(s[16:], 16, 64); err != nil {
+		return "", err
+	}
+
+	// 32 characters, separated by 4 characters
+	ip := ""
+	for i := 0; i < 32; i += 4 {
+		if i > 0 {
+			ip += ":"
+		}
+		ip += s[i : i+4]
+	}
+	return ip, nil
+}
@@ -7,13 +7,22 @@ func TestParseIP(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	if ipv6 != "750C:EBC1:C536:110C:C100:B640:2601:0645" {
-		t.Fatalf("ipv6 expected '750C:EBC1:C536:110C:C100:B640:2601:0645', got %s", ipv6)
+	if ipv6 != "2601:0645:C100:B640:C536:110C:750C:EBC1" {
+		t.Fatalf("ipv6 expected '2601:0645:C100:B640:C536:110C:750C:EBC1', got %s", ipv6)
 	}
 	if port != 45242 {
 		t.Fatalf("port expected '45242', got %d", port)
 	}
 
+	// '::1' as in '/proc/net/tcp6'
+	ipv6, _, err = parseLittleEndianIpv6("00000000000000000000000001000000:0016")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ipv6 != "0000:0000:0000:0000:0000:0000:0000:0001" {
+		t.Fatalf("ipv6 expected '0000:0000:0000:0000:0000:0000:0000:0001', got %s", ipv6)
+	}
+
 	ipv4, port, err := parseLittleEndianIpv4("0101007F:0035")
 	if err != nil {
 		t.Fatal(err)
@@ -25,3 +34,39 @@ func TestParseIP(t *testing.T) {
 		t.Fatalf("port expected '53', got %d", port)
 	}
 }
+
+func TestParseIPAddr(t *testing.T) {
+	tests := []struct {
+		s      string
+		ipv6   bool
+		exp    string
+		expErr bool
+	}{
+		{s: "010200C0", exp: "192.0.2.1"},
+		{s: "00FFFFFF", exp: "255.255.255.0"},
+		{s: "00000000", exp: "0.0.0.0"},
+		{s: "0102C0", expErr: true},
+		{s: "0102ZZC0", expErr: true},
+		{s: "fe800000000000000000000000000001", ipv6: true, exp: "fe80:0000:0000:0000:0000:0000:0000:0001"},
+		{s: "fd000000000000000000000000000002", ipv6: true, exp: "fd00:0000:0000:0000:0000:0000:0000:0002"},
+		{s: "fe80", ipv6: true, expErr: true},
+		{s: "fe80000000000000000000000000000z", ipv6: true, expErr: true},
+	}
+	for i, tt := range tests {
+		var (
+			ip  string
+			err error
+		)
+		if tt.ipv6 {
+			ip, err = parseIpv6Addr(tt.s)
+		} else {
+			ip, err = parseLittleEndianIpv4Addr(tt.s)
+		}
+		if (err != nil) != tt.expErr {
+			t.Fatalf("#%d: expected error %v, got %v", i, tt.expErr, err)
+		}
+		if ip != tt.exp {
+			t.Fatalf("#%d: expected %q, got %q", i, tt.exp, ip)
+		}
+	}
+}
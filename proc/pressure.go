@@ -0,0 +1,128 @@
+package proc
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gyuho/linux-inspect/pkg/fileutil"
+)
+
+// Pressure is the pressure stall information (PSI) of a resource.
+// Reference https://www.kernel.org/doc/Documentation/accounting/psi.txt.
+type Pressure struct {
+	// Some is the share of time in which at least some tasks
+	// are stalled on the resource.
+	Some PressureStat
+	// Full is the share of time in which all non-idle tasks are
+	// stalled on the resource simultaneously. 'full' for CPU is
+	// always zero system-wide, and not set in cgroup 'cpu.pressure'
+	// before Linux 5.13.
+	Full PressureStat
+}
+
+// SystemPressure is '/proc/pressure' in Linux (4.20+).
+type SystemPressure struct {
+	CPU    Pressure
+	Memory Pressure
+	IO     Pressure
+}
+
+// GetPressure reads '/proc/pressure/cpu', '/proc/pressure/memory'
+// and '/proc/pressure/io'. It fails if the kernel does not
+// support PSI, or PSI is disabled with 'psi=0'.
+func GetPressure() (SystemPressure, error) {
+	return defaultFS.GetPressure()
+}
+
+// GetPressure reads '$ROOT/pressure/*'.
+func (fs FS) GetPressure() (SystemPressure, error) {
+	var sp SystemPressure
+	for _, rs := range []struct {
+		name string
+		p    *Pressure
+	}{
+		{"cpu", &sp.CPU},
+		{"memory", &sp.Memory},
+		{"io", &sp.IO},
+	} {
+		p, err := fs.getPressure(rs.name)
+		if err != nil {
+			return SystemPressure{}, err
+		}
+		*rs.p = p
+	}
+	return sp, nil
+}
+
+func (fs FS) getPressure(resource string) (Pressure, error) {
+	f, err := fileutil.OpenToRead(fs.path("pressure", resource))
+	if err != nil {
+		return Pressure{}, err
+	}
+	defer f.Close()
+
+	d, err := ioutil.ReadAll(f)
+	if err != nil {
+		return Pressure{}, err
+	}
+	return ParsePressure(d)
+}
+
+// ParsePressure parses
+// 'some avg10=0.00 avg60=0.00 avg300=0.00 total=0', the format of
+// '/proc/pressure/*' and of cgroup v2 'cpu.pressure', 'memory.pressure'
+// and 'io.pressure'.
+func ParsePressure(d []byte) (Pressure, error) {
+	var p Pressure
+	scanner := bufio.NewScanner(bytes.NewReader(d))
+	for scanner.Scan() {
+		txt := scanner.Text()
+		fs := strings.Fields(txt)
+		if len(fs) == 0 {
+			continue
+		}
+		if len(fs) != 5 {
+			return Pressure{}, fmt.Errorf("unknown pressure line %q", txt)
+		}
+
+		var s PressureStat
+		for _, f := range fs[1:] {
+			kv := strings.SplitN(f, "=", 2)
+			if len(kv) != 2 {
+				return Pressure{}, fmt.Errorf("unknown pressure field %q at %q", f, txt)
+			}
+			var err error
+			switch kv[0] {
+			case "avg10":
+				s.Avg10, err = strconv.ParseFloat(kv[1], 64)
+			case "avg60":
+				s.Avg60, err = strconv.ParseFloat(kv[1], 64)
+			case "avg300":
+				s.Avg300, err = strconv.ParseFloat(kv[1], 64)
+			case "total":
+				s.Total, err = strconv.ParseUint(kv[1], 10, 64)
+			default:
+				err = fmt.Errorf("unknown pressure field %q at %q", f, txt)
+			}
+			if err != nil {
+				return Pressure{}, err
+			}
+		}
+		s.TotalParsedTime = (time.Duration(s.Total) * time.Microsecond).String()
+
+		switch fs[0] {
+		case "some":
+			p.Some = s
+		case "full":
+			p.Full = s
+		default:
+			return Pressure{}, fmt.Errorf("unknown pressure line %q", txt)
+		}
+	}
+	return p, scanner.Err()
+}
@@ -0,0 +1,78 @@
+package proc
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParsePressure(t *testing.T) {
+	p, err := ParsePressure([]byte(`some avg10=0.22 avg60=0.17 avg300=1.11 total=58761459
+full avg10=0.10 avg60=0.05 avg300=0.50 total=29380729
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Some.Avg10 != 0.22 || p.Some.Avg60 != 0.17 || p.Some.Avg300 != 1.11 || p.Some.Total != 58761459 || p.Some.TotalParsedTime != "58.761459s" {
+		t.Fatalf("unexpected some %+v", p.Some)
+	}
+	if p.Full.Avg10 != 0.10 || p.Full.Avg300 != 0.50 || p.Full.Total != 29380729 {
+		t.Fatalf("unexpected full %+v", p.Full)
+	}
+
+	// no 'full' line for CPU before Linux 5.13
+	p, err = ParsePressure([]byte("some avg10=1.00 avg60=0.00 avg300=0.00 total=10\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Some.Avg10 != 1 || p.Full != (PressureStat{}) {
+		t.Fatalf("unexpected %+v", p)
+	}
+
+	for _, txt := range []string{
+		"none avg10=0.00 avg60=0.00 avg300=0.00 total=0\n",
+		"some avg10=0.00 avg60=0.00 avg300=0.00\n",
+		"some avg10=x avg60=0.00 avg300=0.00 total=0\n",
+	} {
+		if _, err = ParsePressure([]byte(txt)); err == nil {
+			t.Fatalf("expected error for %q", txt)
+		}
+	}
+}
+
+func TestFSGetPressure(t *testing.T) {
+	root, err := ioutil.TempDir(os.TempDir(), "proc-pressure-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	if err = os.MkdirAll(filepath.Join(root, "pressure"), 0777); err != nil {
+		t.Fatal(err)
+	}
+	fs, err := NewFS(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = fs.GetPressure(); !os.IsNotExist(err) {
+		t.Fatalf("expected not exist error, got %v", err)
+	}
+
+	for name, txt := range map[string]string{
+		"cpu":    "some avg10=1.50 avg60=0.00 avg300=0.00 total=100\n",
+		"memory": "some avg10=0.00 avg60=2.50 avg300=0.00 total=200\nfull avg10=0.00 avg60=1.25 avg300=0.00 total=100\n",
+		"io":     "some avg10=0.00 avg60=0.00 avg300=3.50 total=300\nfull avg10=0.00 avg60=0.00 avg300=1.75 total=150\n",
+	} {
+		if err = ioutil.WriteFile(filepath.Join(root, "pressure", name), []byte(txt), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	sp, err := fs.GetPressure()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sp.CPU.Some.Avg10 != 1.5 || sp.Memory.Full.Avg60 != 1.25 || sp.IO.Some.Avg300 != 3.5 || sp.IO.Full.Total != 150 {
+		t.Fatalf("unexpected %+v", sp)
+	}
+}
@@ -38,6 +38,7 @@ var NetDevSchema = schema.RawData{
 }
 
 // NetTCPSchema represents '/proc/net/tcp' and '/proc/net/tcp6'.
+// '/proc/net/udp(6)' and '/proc/net/raw(6)' have the same format.
 // Reference http://man7.org/linux/man-pages/man5/proc.5.html
 // and http://www.onlamp.com/pub/a/linux/2000/11/16/LinuxAdmin.html.
 var NetTCPSchema = schema.RawData{
@@ -63,6 +64,26 @@ var NetTCPSchema = schema.RawData{
 	},
 }
 
+// NetUnixSchema represents '/proc/net/unix'.
+// Reference http://man7.org/linux/man-pages/man5/proc.5.html.
+var NetUnixSchema = schema.RawData{
+	IsYAML: false,
+	Columns: []schema.Column{
+		{Name: "num", Godoc: "kernel address of the socket", Kind: reflect.String},
+		{Name: "ref_count", Godoc: "number of users of the socket", Kind: reflect.String},
+		{Name: "protocol", Godoc: "currently always 0", Kind: reflect.String},
+		{Name: "flags", Godoc: "internal kernel flags holding the status of the socket", Kind: reflect.String},
+		{Name: "type", Godoc: "socket type (0001 for SOCK_STREAM, 0002 for SOCK_DGRAM, 0005 for SOCK_SEQPACKET)", Kind: reflect.String},
+		{Name: "st", Godoc: "internal state of the socket", Kind: reflect.String},
+		{Name: "inode", Godoc: "inode raw data", Kind: reflect.String},
+		{Name: "path", Godoc: "bound path name (if any) of the socket ('@' prefix for abstract sockets)", Kind: reflect.String},
+	},
+	ColumnsToParse: map[string]schema.RawDataType{
+		"type": schema.TypeStatus,
+		"st":   schema.TypeStatus,
+	},
+}
+
 // LoadAvgSchema represents '/proc/loadavg'.
 // Reference http://man7.org/linux/man-pages/man5/proc.5.html.
 var LoadAvgSchema = schema.RawData{
@@ -124,6 +145,415 @@ var DiskStatSchema = schema.RawData{
 	},
 }
 
+// CPUStatSchema represents a 'cpu' line in '/proc/stat'.
+// Values are in units of USER_HZ ('_SC_CLK_TCK', typically 1/100 of a second).
+// Reference http://man7.org/linux/man-pages/man5/proc.5.html.
+var CPUStatSchema = schema.RawData{
+	IsYAML: false,
+	Columns: []schema.Column{
+		{Name: "name", Godoc: "CPU name ('cpu' for the aggregate of all CPUs, 'cpu0', 'cpu1', ... for each CPU)", Kind: reflect.String},
+		{Name: "user", Godoc: "time spent in user mode", Kind: reflect.Uint64},
+		{Name: "nice", Godoc: "time spent in user mode with low priority (nice)", Kind: reflect.Uint64},
+		{Name: "system", Godoc: "time spent in system mode", Kind: reflect.Uint64},
+		{Name: "idle", Godoc: "time spent in the idle task", Kind: reflect.Uint64},
+		{Name: "iowait", Godoc: "time waiting for I/O to complete (not reliable)", Kind: reflect.Uint64},
+		{Name: "irq", Godoc: "time servicing interrupts", Kind: reflect.Uint64},
+		{Name: "softirq", Godoc: "time servicing softirqs", Kind: reflect.Uint64},
+		{Name: "steal", Godoc: "stolen time, which is the time spent in other operating systems when running in a virtualized environment", Kind: reflect.Uint64},
+		{Name: "guest", Godoc: "time spent running a virtual CPU for guest operating systems (accounted in user)", Kind: reflect.Uint64},
+		{Name: "guest_nice", Godoc: "time spent running a niced guest (accounted in nice)", Kind: reflect.Uint64},
+	},
+	ColumnsToParse: map[string]schema.RawDataType{},
+}
+
+// SystemStatSchema represents '/proc/stat'.
+// Reference http://man7.org/linux/man-pages/man5/proc.5.html.
+var SystemStatSchema = schema.RawData{
+	IsYAML: false,
+	Columns: []schema.Column{
+		{Name: "intr", Godoc: "total number of interrupts serviced since boot", Kind: reflect.Uint64},
+		{Name: "ctxt", Godoc: "number of context switches that the system underwent", Kind: reflect.Uint64},
+		{Name: "btime", Godoc: "boot time, in seconds since the Epoch, 1970-01-01 00:00:00 +0000 (UTC)", Kind: reflect.Uint64},
+		{Name: "processes", Godoc: "number of forks since boot", Kind: reflect.Uint64},
+		{Name: "procs_running", Godoc: "number of processes in runnable state", Kind: reflect.Uint64},
+		{Name: "procs_blocked", Godoc: "number of processes blocked waiting for I/O to complete", Kind: reflect.Uint64},
+		{Name: "softirq", Godoc: "total number of softirqs serviced since boot", Kind: reflect.Uint64},
+	},
+	ColumnsToParse: map[string]schema.RawDataType{},
+}
+
+// MeminfoSchema represents '/proc/meminfo'.
+// Reference http://man7.org/linux/man-pages/man5/proc.5.html
+// and https://www.kernel.org/doc/Documentation/filesystems/proc.txt.
+var MeminfoSchema = schema.RawData{
+	IsYAML: true,
+	Columns: []schema.Column{
+		{Name: "MemTotal", Godoc: "total usable RAM (i.e., physical RAM minus a few reserved bits and the kernel binary code)", Kind: reflect.String},
+		{Name: "MemFree", Godoc: "sum of LowFree and HighFree", Kind: reflect.String},
+		{Name: "MemAvailable", Godoc: "estimate of how much memory is available for starting new applications, without swapping", Kind: reflect.String},
+		{Name: "Buffers", Godoc: "relatively temporary storage for raw disk blocks", Kind: reflect.String},
+		{Name: "Cached", Godoc: "in-memory cache for files read from the disk (the page cache), not including SwapCached", Kind: reflect.String},
+		{Name: "SwapCached", Godoc: "memory that once was swapped out, is swapped back in but still also is in the swap file", Kind: reflect.String},
+		{Name: "Active", Godoc: "memory that has been used more recently and usually not reclaimed unless absolutely necessary", Kind: reflect.String},
+		{Name: "Inactive", Godoc: "memory which has been less recently used and is more eligible to be reclaimed for other purposes", Kind: reflect.String},
+		{Name: "Active(anon)", Godoc: "anonymous memory that has been used more recently", Kind: reflect.String},
+		{Name: "Inactive(anon)", Godoc: "anonymous memory that has been less recently used and can be swapped out", Kind: reflect.String},
+		{Name: "Active(file)", Godoc: "page cache memory that has been used more recently", Kind: reflect.String},
+		{Name: "Inactive(file)", Godoc: "page cache memory that can be reclaimed without huge performance impact", Kind: reflect.String},
+		{Name: "Unevictable", Godoc: "memory that cannot be reclaimed (e.g. mlocked pages, ramfs)", Kind: reflect.String},
+		{Name: "Mlocked", Godoc: "memory locked with mlock", Kind: reflect.String},
+		{Name: "SwapTotal", Godoc: "total amount of swap space available", Kind: reflect.String},
+		{Name: "SwapFree", Godoc: "amount of swap space that is currently unused", Kind: reflect.String},
+		{Name: "Dirty", Godoc: "memory which is waiting to get written back to the disk", Kind: reflect.String},
+		{Name: "Writeback", Godoc: "memory which is actively being written back to the disk", Kind: reflect.String},
+		{Name: "AnonPages", Godoc: "non-file backed pages mapped into user-space page tables", Kind: reflect.String},
+		{Name: "Mapped", Godoc: "files which have been mapped into memory (with mmap), such as libraries", Kind: reflect.String},
+		{Name: "Shmem", Godoc: "amount of memory consumed in tmpfs filesystems", Kind: reflect.String},
+		{Name: "KReclaimable", Godoc: "kernel allocations that the kernel will attempt to reclaim under memory pressure", Kind: reflect.String},
+		{Name: "Slab", Godoc: "in-kernel data structures cache", Kind: reflect.String},
+		{Name: "SReclaimable", Godoc: "part of Slab, that might be reclaimed, such as caches", Kind: reflect.String},
+		{Name: "SUnreclaim", Godoc: "part of Slab, that cannot be reclaimed on memory pressure", Kind: reflect.String},
+		{Name: "KernelStack", Godoc: "amount of memory allocated to kernel stacks", Kind: reflect.String},
+		{Name: "PageTables", Godoc: "amount of memory dedicated to the lowest level of page tables", Kind: reflect.String},
+		{Name: "NFS_Unstable", Godoc: "NFS pages sent to the server, but not yet committed to stable storage", Kind: reflect.String},
+		{Name: "Bounce", Godoc: "memory used for block device bounce buffers", Kind: reflect.String},
+		{Name: "WritebackTmp", Godoc: "memory used by FUSE for temporary writeback buffers", Kind: reflect.String},
+		{Name: "CommitLimit", Godoc: "total amount of memory currently available to be allocated on the system, based on the overcommit ratio", Kind: reflect.String},
+		{Name: "Committed_AS", Godoc: "amount of memory presently allocated on the system", Kind: reflect.String},
+		{Name: "VmallocTotal", Godoc: "total size of vmalloc memory area", Kind: reflect.String},
+		{Name: "VmallocUsed", Godoc: "amount of vmalloc area which is used", Kind: reflect.String},
+		{Name: "VmallocChunk", Godoc: "largest contiguous block of vmalloc area which is free", Kind: reflect.String},
+		{Name: "Percpu", Godoc: "memory allocated to the per-cpu allocator used to back per-cpu allocations", Kind: reflect.String},
+		{Name: "HardwareCorrupted", Godoc: "amount of memory the kernel identifies as corrupted", Kind: reflect.String},
+		{Name: "AnonHugePages", Godoc: "non-file backed huge pages mapped into user-space page tables", Kind: reflect.String},
+		{Name: "ShmemHugePages", Godoc: "memory used by shared memory (shmem) and tmpfs allocated with huge pages", Kind: reflect.String},
+		{Name: "ShmemPmdMapped", Godoc: "shared memory mapped into user space with huge pages", Kind: reflect.String},
+		{Name: "CmaTotal", Godoc: "total CMA (Contiguous Memory Allocator) pages", Kind: reflect.String},
+		{Name: "CmaFree", Godoc: "free CMA (Contiguous Memory Allocator) pages", Kind: reflect.String},
+		{Name: "HugePages_Total", Godoc: "size of the pool of huge pages", Kind: reflect.Uint64},
+		{Name: "HugePages_Free", Godoc: "number of huge pages in the pool that are not yet allocated", Kind: reflect.Uint64},
+		{Name: "HugePages_Rsvd", Godoc: "number of huge pages for which a commitment to allocate from the pool has been made, but no allocation has yet been made", Kind: reflect.Uint64},
+		{Name: "HugePages_Surp", Godoc: "number of huge pages in the pool above the value in /proc/sys/vm/nr_hugepages", Kind: reflect.Uint64},
+		{Name: "Hugepagesize", Godoc: "size of huge pages", Kind: reflect.String},
+		{Name: "Hugetlb", Godoc: "total amount of memory consumed by huge pages of all sizes", Kind: reflect.String},
+		{Name: "DirectMap4k", Godoc: "number of bytes of RAM linearly mapped by kernel in 4kB pages", Kind: reflect.String},
+		{Name: "DirectMap2M", Godoc: "number of bytes of RAM linearly mapped by kernel in 2MB pages", Kind: reflect.String},
+		{Name: "DirectMap1G", Godoc: "number of bytes of RAM linearly mapped by kernel in 1GB pages", Kind: reflect.String},
+	},
+	ColumnsToParse: map[string]schema.RawDataType{
+		"MemTotal":          schema.TypeBytes,
+		"MemFree":           schema.TypeBytes,
+		"MemAvailable":      schema.TypeBytes,
+		"Buffers":           schema.TypeBytes,
+		"Cached":            schema.TypeBytes,
+		"SwapCached":        schema.TypeBytes,
+		"Active":            schema.TypeBytes,
+		"Inactive":          schema.TypeBytes,
+		"Active(anon)":      schema.TypeBytes,
+		"Inactive(anon)":    schema.TypeBytes,
+		"Active(file)":      schema.TypeBytes,
+		"Inactive(file)":    schema.TypeBytes,
+		"Unevictable":       schema.TypeBytes,
+		"Mlocked":           schema.TypeBytes,
+		"SwapTotal":         schema.TypeBytes,
+		"SwapFree":          schema.TypeBytes,
+		"Dirty":             schema.TypeBytes,
+		"Writeback":         schema.TypeBytes,
+		"AnonPages":         schema.TypeBytes,
+		"Mapped":            schema.TypeBytes,
+		"Shmem":             schema.TypeBytes,
+		"KReclaimable":      schema.TypeBytes,
+		"Slab":              schema.TypeBytes,
+		"SReclaimable":      schema.TypeBytes,
+		"SUnreclaim":        schema.TypeBytes,
+		"KernelStack":       schema.TypeBytes,
+		"PageTables":        schema.TypeBytes,
+		"NFS_Unstable":      schema.TypeBytes,
+		"Bounce":            schema.TypeBytes,
+		"WritebackTmp":      schema.TypeBytes,
+		"CommitLimit":       schema.TypeBytes,
+		"Committed_AS":      schema.TypeBytes,
+		"VmallocTotal":      schema.TypeBytes,
+		"VmallocUsed":       schema.TypeBytes,
+		"VmallocChunk":      schema.TypeBytes,
+		"Percpu":            schema.TypeBytes,
+		"HardwareCorrupted": schema.TypeBytes,
+		"AnonHugePages":     schema.TypeBytes,
+		"ShmemHugePages":    schema.TypeBytes,
+		"ShmemPmdMapped":    schema.TypeBytes,
+		"CmaTotal":          schema.TypeBytes,
+		"CmaFree":           schema.TypeBytes,
+		"Hugepagesize":      schema.TypeBytes,
+		"Hugetlb":           schema.TypeBytes,
+		"DirectMap4k":       schema.TypeBytes,
+		"DirectMap2M":       schema.TypeBytes,
+		"DirectMap1G":       schema.TypeBytes,
+	},
+}
+
+// SmapsSchema represents a mapping in '/proc/$PID/smaps',
+// or the sum of all mappings in '/proc/$PID/smaps_rollup'.
+// Reference https://www.kernel.org/doc/Documentation/filesystems/proc.txt.
+var SmapsSchema = schema.RawData{
+	IsYAML: true,
+	Columns: []schema.Column{
+		{Name: "Size", Godoc: "size of the mapping (not set in smaps_rollup)", Kind: reflect.String},
+		{Name: "Rss", Godoc: "amount of the mapping that is currently resident in RAM", Kind: reflect.String},
+		{Name: "Pss", Godoc: "proportional share of the mapping, where each shared page is divided by the number of processes sharing it", Kind: reflect.String},
+		{Name: "Shared_Clean", Godoc: "clean pages in the mapping that are shared with other processes", Kind: reflect.String},
+		{Name: "Shared_Dirty", Godoc: "dirty pages in the mapping that are shared with other processes", Kind: reflect.String},
+		{Name: "Private_Clean", Godoc: "clean pages in the mapping that are only used by this process", Kind: reflect.String},
+		{Name: "Private_Dirty", Godoc: "dirty pages in the mapping that are only used by this process", Kind: reflect.String},
+		{Name: "Referenced", Godoc: "amount of memory currently marked as referenced or accessed", Kind: reflect.String},
+		{Name: "Anonymous", Godoc: "amount of memory that does not belong to any file", Kind: reflect.String},
+		{Name: "AnonHugePages", Godoc: "amount of memory backed by transparent huge pages", Kind: reflect.String},
+		{Name: "Swap", Godoc: "amount of would-be-anonymous memory that is swapped out", Kind: reflect.String},
+		{Name: "SwapPss", Godoc: "proportional share of the swapped out memory", Kind: reflect.String},
+		{Name: "Locked", Godoc: "amount of memory locked in RAM", Kind: reflect.String},
+	},
+	ColumnsToParse: map[string]schema.RawDataType{
+		"Size":          schema.TypeBytes,
+		"Rss":           schema.TypeBytes,
+		"Pss":           schema.TypeBytes,
+		"Shared_Clean":  schema.TypeBytes,
+		"Shared_Dirty":  schema.TypeBytes,
+		"Private_Clean": schema.TypeBytes,
+		"Private_Dirty": schema.TypeBytes,
+		"Referenced":    schema.TypeBytes,
+		"Anonymous":     schema.TypeBytes,
+		"AnonHugePages": schema.TypeBytes,
+		"Swap":          schema.TypeBytes,
+		"SwapPss":       schema.TypeBytes,
+		"Locked":        schema.TypeBytes,
+	},
+}
+
+// PressureStatSchema represents a 'some' or 'full' line in
+// '/proc/pressure/cpu', '/proc/pressure/memory' and '/proc/pressure/io'.
+// Reference https://www.kernel.org/doc/Documentation/accounting/psi.txt.
+var PressureStatSchema = schema.RawData{
+	IsYAML: false,
+	Columns: []schema.Column{
+		{Name: "avg10", Godoc: "percentage of time stalled over the last 10 seconds", Kind: reflect.Float64},
+		{Name: "avg60", Godoc: "percentage of time stalled over the last 60 seconds", Kind: reflect.Float64},
+		{Name: "avg300", Godoc: "percentage of time stalled over the last 300 seconds", Kind: reflect.Float64},
+		{Name: "total", Godoc: "total stall time in microseconds", Kind: reflect.Uint64},
+	},
+	ColumnsToParse: map[string]schema.RawDataType{
+		"total": schema.TypeTimeMicroseconds,
+	},
+}
+
+// NetSnmpIPSchema represents the 'Ip' section in '/proc/net/snmp'.
+// Reference https://tools.ietf.org/html/rfc1213.
+var NetSnmpIPSchema = schema.RawData{
+	IsYAML: true,
+	Columns: []schema.Column{
+		{Name: "Forwarding", Godoc: "1 if forwarding IP datagrams, 2 otherwise", Kind: reflect.Uint64},
+		{Name: "DefaultTTL", Godoc: "default TTL of IP datagrams originated by this host", Kind: reflect.Uint64},
+		{Name: "InReceives", Godoc: "total number of input datagrams received from interfaces", Kind: reflect.Uint64},
+		{Name: "InHdrErrors", Godoc: "number of input datagrams discarded due to errors in IP headers", Kind: reflect.Uint64},
+		{Name: "InAddrErrors", Godoc: "number of input datagrams discarded due to invalid destination addresses", Kind: reflect.Uint64},
+		{Name: "ForwDatagrams", Godoc: "number of input datagrams forwarded", Kind: reflect.Uint64},
+		{Name: "InUnknownProtos", Godoc: "number of input datagrams discarded due to unknown or unsupported protocols", Kind: reflect.Uint64},
+		{Name: "InDiscards", Godoc: "number of input datagrams discarded for no problems (e.g. lack of buffer space)", Kind: reflect.Uint64},
+		{Name: "InDelivers", Godoc: "total number of input datagrams delivered to IP user-protocols", Kind: reflect.Uint64},
+		{Name: "OutRequests", Godoc: "total number of datagrams supplied to IP for transmission", Kind: reflect.Uint64},
+		{Name: "OutDiscards", Godoc: "number of output datagrams discarded for no problems (e.g. lack of buffer space)", Kind: reflect.Uint64},
+		{Name: "OutNoRoutes", Godoc: "number of datagrams discarded because no route could be found", Kind: reflect.Uint64},
+		{Name: "ReasmTimeout", Godoc: "maximum number of seconds received fragments are held for reassembly", Kind: reflect.Uint64},
+		{Name: "ReasmReqds", Godoc: "number of fragments received which needed to be reassembled", Kind: reflect.Uint64},
+		{Name: "ReasmOKs", Godoc: "number of datagrams successfully reassembled", Kind: reflect.Uint64},
+		{Name: "ReasmFails", Godoc: "number of failures detected by the reassembly algorithm", Kind: reflect.Uint64},
+		{Name: "FragOKs", Godoc: "number of datagrams successfully fragmented", Kind: reflect.Uint64},
+		{Name: "FragFails", Godoc: "number of datagrams discarded because they could not be fragmented", Kind: reflect.Uint64},
+		{Name: "FragCreates", Godoc: "number of datagram fragments generated by fragmentation", Kind: reflect.Uint64},
+		{Name: "OutTransmits", Godoc: "number of datagrams passed to the link layer, including fragments", Kind: reflect.Uint64},
+	},
+	ColumnsToParse: map[string]schema.RawDataType{},
+}
+
+// NetSnmpICMPSchema represents the 'Icmp' section in '/proc/net/snmp'.
+// Reference https://tools.ietf.org/html/rfc1213.
+var NetSnmpICMPSchema = schema.RawData{
+	IsYAML: true,
+	Columns: []schema.Column{
+		{Name: "InMsgs", Godoc: "total number of ICMP messages received", Kind: reflect.Uint64},
+		{Name: "InErrors", Godoc: "number of ICMP messages received but determined as having errors", Kind: reflect.Uint64},
+		{Name: "InCsumErrors", Godoc: "number of ICMP messages received with bad checksums", Kind: reflect.Uint64},
+		{Name: "InDestUnreachs", Godoc: "number of ICMP Destination Unreachable messages received", Kind: reflect.Uint64},
+		{Name: "InTimeExcds", Godoc: "number of ICMP Time Exceeded messages received", Kind: reflect.Uint64},
+		{Name: "InParmProbs", Godoc: "number of ICMP Parameter Problem messages received", Kind: reflect.Uint64},
+		{Name: "InSrcQuenchs", Godoc: "number of ICMP Source Quench messages received", Kind: reflect.Uint64},
+		{Name: "InRedirects", Godoc: "number of ICMP Redirect messages received", Kind: reflect.Uint64},
+		{Name: "InEchos", Godoc: "number of ICMP Echo request messages received", Kind: reflect.Uint64},
+		{Name: "InEchoReps", Godoc: "number of ICMP Echo Reply messages received", Kind: reflect.Uint64},
+		{Name: "OutMsgs", Godoc: "total number of ICMP messages attempted to send", Kind: reflect.Uint64},
+		{Name: "OutErrors", Godoc: "number of ICMP messages not sent due to problems within ICMP", Kind: reflect.Uint64},
+		{Name: "OutDestUnreachs", Godoc: "number of ICMP Destination Unreachable messages sent", Kind: reflect.Uint64},
+		{Name: "OutTimeExcds", Godoc: "number of ICMP Time Exceeded messages sent", Kind: reflect.Uint64},
+		{Name: "OutParmProbs", Godoc: "number of ICMP Parameter Problem messages sent", Kind: reflect.Uint64},
+		{Name: "OutSrcQuenchs", Godoc: "number of ICMP Source Quench messages sent", Kind: reflect.Uint64},
+		{Name: "OutRedirects", Godoc: "number of ICMP Redirect messages sent", Kind: reflect.Uint64},
+		{Name: "OutEchos", Godoc: "number of ICMP Echo request messages sent", Kind: reflect.Uint64},
+		{Name: "OutEchoReps", Godoc: "number of ICMP Echo Reply messages sent", Kind: reflect.Uint64},
+	},
+	ColumnsToParse: map[string]schema.RawDataType{},
+}
+
+// NetSnmpTCPSchema represents the 'Tcp' section in '/proc/net/snmp'.
+// Reference https://tools.ietf.org/html/rfc1213.
+var NetSnmpTCPSchema = schema.RawData{
+	IsYAML: true,
+	Columns: []schema.Column{
+		{Name: "RtoAlgorithm", Godoc: "algorithm to determine the retransmission timeout (1 for other)", Kind: reflect.Uint64},
+		{Name: "RtoMin", Godoc: "minimum retransmission timeout in milliseconds", Kind: reflect.Uint64},
+		{Name: "RtoMax", Godoc: "maximum retransmission timeout in milliseconds", Kind: reflect.Uint64},
+		{Name: "MaxConn", Godoc: "limit on the total number of TCP connections (-1 if dynamic)", Kind: reflect.Int64},
+		{Name: "ActiveOpens", Godoc: "number of transitions to SYN-SENT from CLOSED", Kind: reflect.Uint64},
+		{Name: "PassiveOpens", Godoc: "number of transitions to SYN-RCVD from LISTEN", Kind: reflect.Uint64},
+		{Name: "AttemptFails", Godoc: "number of failed connection attempts", Kind: reflect.Uint64},
+		{Name: "EstabResets", Godoc: "number of resets from ESTABLISHED or CLOSE-WAIT", Kind: reflect.Uint64},
+		{Name: "CurrEstab", Godoc: "number of connections in ESTABLISHED or CLOSE-WAIT", Kind: reflect.Uint64},
+		{Name: "InSegs", Godoc: "total number of segments received", Kind: reflect.Uint64},
+		{Name: "OutSegs", Godoc: "total number of segments sent, excluding retransmitted segments", Kind: reflect.Uint64},
+		{Name: "RetransSegs", Godoc: "total number of segments retransmitted", Kind: reflect.Uint64},
+		{Name: "InErrs", Godoc: "total number of segments received in error", Kind: reflect.Uint64},
+		{Name: "OutRsts", Godoc: "number of segments sent containing the RST flag", Kind: reflect.Uint64},
+		{Name: "InCsumErrors", Godoc: "number of segments received with bad checksums", Kind: reflect.Uint64},
+	},
+	ColumnsToParse: map[string]schema.RawDataType{},
+}
+
+// NetSnmpUDPSchema represents the 'Udp' and 'UdpLite' sections in '/proc/net/snmp'.
+// Reference https://tools.ietf.org/html/rfc1213.
+var NetSnmpUDPSchema = schema.RawData{
+	IsYAML: true,
+	Columns: []schema.Column{
+		{Name: "InDatagrams", Godoc: "total number of datagrams delivered to users", Kind: reflect.Uint64},
+		{Name: "NoPorts", Godoc: "number of datagrams received with no application at the destination port", Kind: reflect.Uint64},
+		{Name: "InErrors", Godoc: "number of datagrams that could not be delivered, other than 'NoPorts'", Kind: reflect.Uint64},
+		{Name: "OutDatagrams", Godoc: "total number of datagrams sent", Kind: reflect.Uint64},
+		{Name: "RcvbufErrors", Godoc: "number of datagrams dropped because the socket receive buffer was full", Kind: reflect.Uint64},
+		{Name: "SndbufErrors", Godoc: "number of datagrams dropped because the socket send buffer was full", Kind: reflect.Uint64},
+		{Name: "InCsumErrors", Godoc: "number of datagrams received with bad checksums", Kind: reflect.Uint64},
+		{Name: "IgnoredMulti", Godoc: "number of broadcast or multicast datagrams ignored", Kind: reflect.Uint64},
+		{Name: "MemErrors", Godoc: "number of datagrams dropped due to memory accounting limits", Kind: reflect.Uint64},
+	},
+	ColumnsToParse: map[string]schema.RawDataType{},
+}
+
+// NetSnmp6Schema represents '/proc/net/snmp6'.
+// Reference https://tools.ietf.org/html/rfc4293.
+var NetSnmp6Schema = schema.RawData{
+	IsYAML: true,
+	Columns: []schema.Column{
+		{Name: "Ip6InReceives", Godoc: "total number of input IPv6 datagrams received", Kind: reflect.Uint64},
+		{Name: "Ip6InHdrErrors", Godoc: "number of input IPv6 datagrams discarded due to errors in headers", Kind: reflect.Uint64},
+		{Name: "Ip6InNoRoutes", Godoc: "number of input IPv6 datagrams discarded because no route could be found", Kind: reflect.Uint64},
+		{Name: "Ip6InAddrErrors", Godoc: "number of input IPv6 datagrams discarded due to invalid destination addresses", Kind: reflect.Uint64},
+		{Name: "Ip6InDiscards", Godoc: "number of input IPv6 datagrams discarded for no problems (e.g. lack of buffer space)", Kind: reflect.Uint64},
+		{Name: "Ip6InDelivers", Godoc: "total number of input IPv6 datagrams delivered to user-protocols", Kind: reflect.Uint64},
+		{Name: "Ip6OutRequests", Godoc: "total number of IPv6 datagrams supplied for transmission", Kind: reflect.Uint64},
+		{Name: "Ip6OutDiscards", Godoc: "number of output IPv6 datagrams discarded for no problems", Kind: reflect.Uint64},
+		{Name: "Ip6OutNoRoutes", Godoc: "number of IPv6 datagrams discarded because no route could be found", Kind: reflect.Uint64},
+		{Name: "Ip6InOctets", Godoc: "total number of octets received in input IPv6 datagrams", Kind: reflect.Uint64},
+		{Name: "Ip6OutOctets", Godoc: "total number of octets sent in IPv6 datagrams", Kind: reflect.Uint64},
+		{Name: "Icmp6InMsgs", Godoc: "total number of ICMPv6 messages received", Kind: reflect.Uint64},
+		{Name: "Icmp6InErrors", Godoc: "number of ICMPv6 messages received but determined as having errors", Kind: reflect.Uint64},
+		{Name: "Icmp6OutMsgs", Godoc: "total number of ICMPv6 messages attempted to send", Kind: reflect.Uint64},
+		{Name: "Icmp6OutErrors", Godoc: "number of ICMPv6 messages not sent due to problems within ICMPv6", Kind: reflect.Uint64},
+		{Name: "Icmp6InDestUnreachs", Godoc: "number of ICMPv6 Destination Unreachable messages received", Kind: reflect.Uint64},
+		{Name: "Icmp6OutDestUnreachs", Godoc: "number of ICMPv6 Destination Unreachable messages sent", Kind: reflect.Uint64},
+		{Name: "Udp6InDatagrams", Godoc: "total number of UDPv6 datagrams delivered to users", Kind: reflect.Uint64},
+		{Name: "Udp6NoPorts", Godoc: "number of UDPv6 datagrams received with no application at the destination port", Kind: reflect.Uint64},
+		{Name: "Udp6InErrors", Godoc: "number of UDPv6 datagrams that could not be delivered, other than 'Udp6NoPorts'", Kind: reflect.Uint64},
+		{Name: "Udp6OutDatagrams", Godoc: "total number of UDPv6 datagrams sent", Kind: reflect.Uint64},
+		{Name: "Udp6RcvbufErrors", Godoc: "number of UDPv6 datagrams dropped because the socket receive buffer was full", Kind: reflect.Uint64},
+		{Name: "Udp6SndbufErrors", Godoc: "number of UDPv6 datagrams dropped because the socket send buffer was full", Kind: reflect.Uint64},
+		{Name: "Udp6InCsumErrors", Godoc: "number of UDPv6 datagrams received with bad checksums", Kind: reflect.Uint64},
+		{Name: "Udp6IgnoredMulti", Godoc: "number of UDPv6 multicast datagrams ignored", Kind: reflect.Uint64},
+		{Name: "Udp6MemErrors", Godoc: "number of UDPv6 datagrams dropped due to memory accounting limits", Kind: reflect.Uint64},
+	},
+	ColumnsToParse: map[string]schema.RawDataType{},
+}
+
+// NetstatTCPExtSchema represents the 'TcpExt' section in '/proc/net/netstat'.
+// Reference https://www.kernel.org/doc/Documentation/networking/snmp_counter.rst.
+var NetstatTCPExtSchema = schema.RawData{
+	IsYAML: true,
+	Columns: []schema.Column{
+		{Name: "SyncookiesSent", Godoc: "number of SYN cookies sent", Kind: reflect.Uint64},
+		{Name: "SyncookiesRecv", Godoc: "number of valid SYN cookies received", Kind: reflect.Uint64},
+		{Name: "SyncookiesFailed", Godoc: "number of invalid SYN cookies received", Kind: reflect.Uint64},
+		{Name: "EmbryonicRsts", Godoc: "number of resets received for embryonic SYN-RCVD sockets", Kind: reflect.Uint64},
+		{Name: "PruneCalled", Godoc: "number of times the receive queue was pruned due to memory pressure", Kind: reflect.Uint64},
+		{Name: "RcvPruned", Godoc: "number of packets dropped from the receive queue after pruning", Kind: reflect.Uint64},
+		{Name: "OfoPruned", Godoc: "number of packets dropped from the out-of-order queue due to memory pressure", Kind: reflect.Uint64},
+		{Name: "TW", Godoc: "number of sockets that finished TIME-WAIT via the fast timer", Kind: reflect.Uint64},
+		{Name: "DelayedACKs", Godoc: "number of delayed ACKs sent", Kind: reflect.Uint64},
+		{Name: "DelayedACKLost", Godoc: "number of times quick ACK mode was entered due to lost delayed ACKs", Kind: reflect.Uint64},
+		{Name: "ListenOverflows", Godoc: "number of times the accept queue of a listening socket overflowed", Kind: reflect.Uint64},
+		{Name: "ListenDrops", Godoc: "number of SYNs to listening sockets dropped, including 'ListenOverflows'", Kind: reflect.Uint64},
+		{Name: "TCPLostRetransmit", Godoc: "number of retransmitted segments that were lost", Kind: reflect.Uint64},
+		{Name: "TCPFastRetrans", Godoc: "number of segments retransmitted by fast retransmit", Kind: reflect.Uint64},
+		{Name: "TCPSlowStartRetrans", Godoc: "number of segments retransmitted in slow start", Kind: reflect.Uint64},
+		{Name: "TCPTimeouts", Godoc: "number of retransmission timeouts (RTO)", Kind: reflect.Uint64},
+		{Name: "TCPLossProbes", Godoc: "number of tail loss probes sent", Kind: reflect.Uint64},
+		{Name: "TCPLossProbeRecovery", Godoc: "number of losses recovered by tail loss probes", Kind: reflect.Uint64},
+		{Name: "TCPSpuriousRTOs", Godoc: "number of spurious retransmission timeouts detected by F-RTO", Kind: reflect.Uint64},
+		{Name: "TCPRetransFail", Godoc: "number of failed retransmits (e.g. lack of memory)", Kind: reflect.Uint64},
+		{Name: "TCPSynRetrans", Godoc: "number of SYN and SYN/ACK retransmits", Kind: reflect.Uint64},
+		{Name: "TCPOrigDataSent", Godoc: "number of outgoing packets with original data, excluding retransmits", Kind: reflect.Uint64},
+		{Name: "TCPRcvCollapsed", Godoc: "number of packets collapsed in the receive queue due to low socket buffer", Kind: reflect.Uint64},
+		{Name: "TCPBacklogDrop", Godoc: "number of packets dropped because the socket backlog was full", Kind: reflect.Uint64},
+		{Name: "TCPOFOQueue", Godoc: "number of packets queued in the out-of-order queue", Kind: reflect.Uint64},
+		{Name: "TCPOFODrop", Godoc: "number of packets dropped from the out-of-order queue due to memory limits", Kind: reflect.Uint64},
+		{Name: "TCPRcvQDrop", Godoc: "number of packets dropped because the receive queue was full", Kind: reflect.Uint64},
+		{Name: "TCPZeroWindowDrop", Godoc: "number of packets dropped due to a zero receive window", Kind: reflect.Uint64},
+		{Name: "TCPToZeroWindowAdv", Godoc: "number of times the advertised window went from non-zero to zero", Kind: reflect.Uint64},
+		{Name: "TCPReqQFullDrop", Godoc: "number of SYNs dropped because the SYN queue was full and SYN cookies were disabled", Kind: reflect.Uint64},
+		{Name: "TCPReqQFullDoCookies", Godoc: "number of SYN cookies sent because the SYN queue was full", Kind: reflect.Uint64},
+		{Name: "TCPAbortOnData", Godoc: "number of connections reset due to unexpected data", Kind: reflect.Uint64},
+		{Name: "TCPAbortOnClose", Godoc: "number of connections reset when closed with unread data", Kind: reflect.Uint64},
+		{Name: "TCPAbortOnMemory", Godoc: "number of connections aborted due to memory pressure", Kind: reflect.Uint64},
+		{Name: "TCPAbortOnTimeout", Godoc: "number of connections aborted after retransmission timeouts", Kind: reflect.Uint64},
+		{Name: "TCPAbortOnLinger", Godoc: "number of connections aborted after the linger timeout", Kind: reflect.Uint64},
+		{Name: "TCPAbortFailed", Godoc: "number of failed attempts to send a reset", Kind: reflect.Uint64},
+		{Name: "TCPMemoryPressures", Godoc: "number of times TCP entered memory pressure", Kind: reflect.Uint64},
+		{Name: "TCPKeepAlive", Godoc: "number of keepalive probes sent", Kind: reflect.Uint64},
+		{Name: "TCPDelivered", Godoc: "number of data packets delivered, including retransmits", Kind: reflect.Uint64},
+	},
+	ColumnsToParse: map[string]schema.RawDataType{},
+}
+
+// NetstatIPExtSchema represents the 'IpExt' section in '/proc/net/netstat'.
+// Reference https://www.kernel.org/doc/Documentation/networking/snmp_counter.rst.
+var NetstatIPExtSchema = schema.RawData{
+	IsYAML: true,
+	Columns: []schema.Column{
+		{Name: "InNoRoutes", Godoc: "number of input datagrams discarded because no route could be found", Kind: reflect.Uint64},
+		{Name: "InTruncatedPkts", Godoc: "number of input datagrams discarded because they were truncated", Kind: reflect.Uint64},
+		{Name: "InMcastPkts", Godoc: "number of multicast datagrams received", Kind: reflect.Uint64},
+		{Name: "OutMcastPkts", Godoc: "number of multicast datagrams sent", Kind: reflect.Uint64},
+		{Name: "InBcastPkts", Godoc: "number of broadcast datagrams received", Kind: reflect.Uint64},
+		{Name: "OutBcastPkts", Godoc: "number of broadcast datagrams sent", Kind: reflect.Uint64},
+		{Name: "InOctets", Godoc: "total number of octets received", Kind: reflect.Uint64},
+		{Name: "OutOctets", Godoc: "total number of octets sent", Kind: reflect.Uint64},
+		{Name: "InMcastOctets", Godoc: "number of octets received in multicast datagrams", Kind: reflect.Uint64},
+		{Name: "OutMcastOctets", Godoc: "number of octets sent in multicast datagrams", Kind: reflect.Uint64},
+		{Name: "InBcastOctets", Godoc: "number of octets received in broadcast datagrams", Kind: reflect.Uint64},
+		{Name: "OutBcastOctets", Godoc: "number of octets sent in broadcast datagrams", Kind: reflect.Uint64},
+		{Name: "InCsumErrors", Godoc: "number of input datagrams with bad checksums", Kind: reflect.Uint64},
+		{Name: "InNoECTPkts", Godoc: "number of datagrams received without ECN", Kind: reflect.Uint64},
+		{Name: "InECT1Pkts", Godoc: "number of datagrams received with ECT(1)", Kind: reflect.Uint64},
+		{Name: "InECT0Pkts", Godoc: "number of datagrams received with ECT(0)", Kind: reflect.Uint64},
+		{Name: "InCEPkts", Godoc: "number of datagrams received with congestion experienced (CE)", Kind: reflect.Uint64},
+		{Name: "ReasmOverlaps", Godoc: "number of fragments discarded due to overlaps", Kind: reflect.Uint64},
+	},
+	ColumnsToParse: map[string]schema.RawDataType{},
+}
+
 // IOSchema represents 'proc/$PID/io'.
 // Reference http://man7.org/linux/man-pages/man5/proc.5.html.
 var IOSchema = schema.RawData{
@@ -0,0 +1,166 @@
+package proc
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/gyuho/linux-inspect/schema"
+
+	humanize "github.com/dustin/go-humanize"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// SmapsMapping is a memory mapping in '/proc/$PID/smaps'.
+type SmapsMapping struct {
+	Map
+	Smaps
+}
+
+// USSBytesN returns the unique set size, the memory that is only
+// used by the process (sum of 'Private_Clean' and 'Private_Dirty').
+func (s Smaps) USSBytesN() uint64 {
+	return s.PrivateCleanBytesN + s.PrivateDirtyBytesN
+}
+
+// GetSmapsRollup reads '/proc/$PID/smaps_rollup', the sum of all
+// mappings. If the kernel does not support 'smaps_rollup' (< 4.14),
+// it sums up the mappings in '/proc/$PID/smaps'.
+func GetSmapsRollup(pid int64) (Smaps, error) {
+	return defaultFS.GetSmapsRollup(pid)
+}
+
+// GetSmapsRollup reads '$ROOT/$PID/smaps_rollup'.
+func (fs FS) GetSmapsRollup(pid int64) (Smaps, error) {
+	d, err := readMaps(fs.pidPath(pid, "smaps_rollup"))
+	if os.IsNotExist(err) {
+		ms, merr := fs.GetSmaps(pid)
+		if merr != nil {
+			return Smaps{}, merr
+		}
+		return sumSmaps(ms), nil
+	}
+	if err != nil {
+		return Smaps{}, err
+	}
+	ms, err := parseSmaps(d)
+	if err != nil {
+		return Smaps{}, err
+	}
+	// kernel threads have no mapping
+	if len(ms) == 0 {
+		return Smaps{}, nil
+	}
+	return ms[0].Smaps, nil
+}
+
+// GetSmaps reads '/proc/$PID/smaps'.
+func GetSmaps(pid int64) ([]SmapsMapping, error) {
+	return defaultFS.GetSmaps(pid)
+}
+
+// GetSmaps reads '$ROOT/$PID/smaps'.
+func (fs FS) GetSmaps(pid int64) ([]SmapsMapping, error) {
+	d, err := readMaps(fs.pidPath(pid, "smaps"))
+	if err != nil {
+		return nil, err
+	}
+	return parseSmaps(d)
+}
+
+// parseSmaps parses the mappings, each of which starts with
+// a header line in the same format as '/proc/$PID/maps'.
+func parseSmaps(d []byte) ([]SmapsMapping, error) {
+	var (
+		ms    []SmapsMapping
+		cur   *SmapsMapping
+		block bytes.Buffer
+	)
+	flush := func() error {
+		if cur == nil {
+			return nil
+		}
+		if err := yaml.Unmarshal(block.Bytes(), &cur.Smaps); err != nil {
+			return fmt.Errorf("%v in mapping %x-%x", err, cur.StartAddress, cur.EndAddress)
+		}
+		if err := parseSmapsBytes(&cur.Smaps); err != nil {
+			return fmt.Errorf("%v in mapping %x-%x", err, cur.StartAddress, cur.EndAddress)
+		}
+		ms = append(ms, *cur)
+		block.Reset()
+		return nil
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(d))
+	for scanner.Scan() {
+		txt := scanner.Text()
+		fields := strings.Fields(txt)
+		if len(fields) == 0 {
+			continue
+		}
+		if strings.HasSuffix(fields[0], ":") {
+			if cur == nil {
+				return nil, fmt.Errorf("unexpected line %q before mapping header", txt)
+			}
+			block.WriteString(txt)
+			block.WriteByte('\n')
+			continue
+		}
+
+		if err := flush(); err != nil {
+			return nil, err
+		}
+		m, err := parseMapsLine(txt)
+		if err != nil {
+			return nil, err
+		}
+		cur = &SmapsMapping{Map: m}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	return ms, nil
+}
+
+func parseSmapsBytes(s *Smaps) error {
+	val := reflect.ValueOf(s).Elem()
+	for name, tp := range SmapsSchema.ColumnsToParse {
+		if tp != schema.TypeBytes {
+			continue
+		}
+		column := schema.ToField(name)
+		u, err := parseKibibytes(val.FieldByName(column).String())
+		if err != nil {
+			return fmt.Errorf("%v in %s", err, name)
+		}
+		val.FieldByName(column + "BytesN").SetUint(u)
+		val.FieldByName(column + "ParsedBytes").SetString(humanize.Bytes(u))
+	}
+	return nil
+}
+
+// sumSmaps sums up the mappings, as in 'smaps_rollup'.
+func sumSmaps(ms []SmapsMapping) Smaps {
+	var sum Smaps
+	val := reflect.ValueOf(&sum).Elem()
+	for name, tp := range SmapsSchema.ColumnsToParse {
+		if tp != schema.TypeBytes || name == "Size" {
+			continue
+		}
+		column := schema.ToField(name)
+		var u uint64
+		for _, m := range ms {
+			u += reflect.ValueOf(m.Smaps).FieldByName(column + "BytesN").Uint()
+		}
+		val.FieldByName(column).SetString(fmt.Sprintf("%d kB", u/1024))
+		val.FieldByName(column + "BytesN").SetUint(u)
+		val.FieldByName(column + "ParsedBytes").SetString(humanize.Bytes(u))
+	}
+	return sum
+}
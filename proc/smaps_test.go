@@ -0,0 +1,128 @@
+package proc
+
+import (
+	"fmt"
+	"os"
+	"testing"
+)
+
+const testSmaps = `00400000-00452000 r-xp 00000000 08:02 173521      /usr/bin/dbus-daemon
+Size:                328 kB
+KernelPageSize:        4 kB
+MMUPageSize:           4 kB
+Rss:                 300 kB
+Pss:                 150 kB
+Shared_Clean:        200 kB
+Shared_Dirty:          0 kB
+Private_Clean:       100 kB
+Private_Dirty:         0 kB
+Referenced:          300 kB
+Anonymous:             0 kB
+AnonHugePages:         0 kB
+Swap:                  0 kB
+SwapPss:               0 kB
+Locked:                0 kB
+THPeligible:           0
+VmFlags: rd ex mr mw me dw
+7f1c3a200000-7f1c3a600000 rw-p 00000000 00:00 0 
+Size:               4096 kB
+Rss:                2048 kB
+Pss:                2048 kB
+Shared_Clean:          0 kB
+Shared_Dirty:          0 kB
+Private_Clean:         0 kB
+Private_Dirty:      2048 kB
+Referenced:         2048 kB
+Anonymous:          2048 kB
+AnonHugePages:      2048 kB
+Swap:                 12 kB
+SwapPss:               8 kB
+Locked:                0 kB
+VmFlags: rd wr mr mw me ac hg
+7ffd0cc5c000-7ffd0cc7d000 rw-p 00000000 00:00 0                          [stack]
+Size:                132 kB
+Rss:                  16 kB
+Pss:                  16 kB
+Private_Dirty:        16 kB
+Anonymous:            16 kB
+VmFlags: rd wr mr mw me gd ac
+`
+
+func TestParseSmaps(t *testing.T) {
+	ms, err := parseSmaps([]byte(testSmaps))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ms) != 3 {
+		t.Fatalf("len(ms) expected 3, got %d", len(ms))
+	}
+	if ms[0].StartAddress != 0x00400000 || ms[0].EndAddress != 0x00452000 || ms[0].Perms != "r-xp" || ms[0].Device != "08:02" || ms[0].Inode != 173521 {
+		t.Fatalf("unexpected header %+v", ms[0])
+	}
+	if ms[0].Pathname != "/usr/bin/dbus-daemon" {
+		t.Fatalf("pathname expected '/usr/bin/dbus-daemon', got %q", ms[0].Pathname)
+	}
+	if ms[0].PssBytesN != 150*1024 || ms[0].SharedCleanBytesN != 200*1024 || ms[0].USSBytesN() != 100*1024 {
+		t.Fatalf("unexpected %+v", ms[0].Smaps)
+	}
+	if ms[1].Pathname != "" {
+		t.Fatalf("anonymous mapping pathname expected empty, got %q", ms[1].Pathname)
+	}
+	if ms[1].AnonHugePagesBytesN != 2048*1024 || ms[1].SwapBytesN != 12*1024 || ms[1].SwapPssBytesN != 8*1024 {
+		t.Fatalf("unexpected %+v", ms[1].Smaps)
+	}
+	if ms[2].Pathname != "[stack]" || ms[2].USSBytesN() != 16*1024 {
+		t.Fatalf("unexpected %+v", ms[2])
+	}
+
+	sum := sumSmaps(ms)
+	if sum.RssBytesN != (300+2048+16)*1024 {
+		t.Fatalf("Rss expected %d, got %d", (300+2048+16)*1024, sum.RssBytesN)
+	}
+	if sum.USSBytesN() != (100+2048+16)*1024 {
+		t.Fatalf("USS expected %d, got %d", (100+2048+16)*1024, sum.USSBytesN())
+	}
+	if sum.SizeBytesN != 0 {
+		t.Fatalf("Size expected 0, got %d", sum.SizeBytesN)
+	}
+}
+
+func TestParseSmapsRollup(t *testing.T) {
+	ms, err := parseSmaps([]byte(`556aba232000-7ffdbed8c000 ---p 00000000 00:00 0                          [rollup]
+Rss:                1420 kB
+Pss:                 489 kB
+Pss_Dirty:           100 kB
+Shared_Clean:       1280 kB
+Private_Clean:        40 kB
+Private_Dirty:       100 kB
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ms) != 1 || ms[0].Pathname != "[rollup]" {
+		t.Fatalf("unexpected %+v", ms)
+	}
+	if ms[0].PssBytesN != 489*1024 || ms[0].USSBytesN() != 140*1024 {
+		t.Fatalf("unexpected %+v", ms[0].Smaps)
+	}
+}
+
+func TestParseSmapsError(t *testing.T) {
+	if _, err := parseSmaps([]byte("Rss: 4 kB\n")); err == nil {
+		t.Fatal("expected error for missing mapping header")
+	}
+	if _, err := parseSmaps([]byte("00400000-0040b000 r-xp 00000000 fd:01 1 /bin/cat\nRss: 4 xB\n")); err == nil {
+		t.Fatal("expected error for malformed Rss")
+	}
+}
+
+func TestGetSmapsRollup(t *testing.T) {
+	s, err := GetSmapsRollup(int64(os.Getpid()))
+	if err != nil {
+		t.Skip(err)
+	}
+	if s.RssBytesN == 0 || s.PssBytesN == 0 {
+		t.Fatalf("unexpected %+v", s)
+	}
+	fmt.Printf("Pss: %s, USS: %d\n", s.PssParsedBytes, s.USSBytesN())
+}
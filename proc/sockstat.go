@@ -0,0 +1,162 @@
+package proc
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/gyuho/linux-inspect/pkg/fileutil"
+)
+
+// SockstatProtocol is a protocol line in '/proc/net/sockstat'
+// or '/proc/net/sockstat6' (e.g. 'TCP: inuse 4 orphan 0 tw 2 alloc 4 mem 0').
+// Fields not reported by the protocol are zero.
+type SockstatProtocol struct {
+	// InUse is the number of sockets in use.
+	InUse uint64
+	// Orphan is the number of sockets not attached to any file descriptor.
+	Orphan uint64
+	// TW is the number of sockets in TIME_WAIT.
+	TW uint64
+	// Alloc is the number of allocated sockets, including TIME_WAIT.
+	Alloc uint64
+	// Mem is the socket buffer memory in pages.
+	Mem uint64
+	// Memory is the IP fragment reassembly memory in bytes.
+	Memory uint64
+}
+
+// Sockstat is '/proc/net/sockstat' and '/proc/net/sockstat6' in Linux.
+type Sockstat struct {
+	// SocketsUsed is the total number of sockets in use.
+	SocketsUsed uint64
+
+	TCP     SockstatProtocol
+	UDP     SockstatProtocol
+	UDPLite SockstatProtocol
+	RAW     SockstatProtocol
+	FRAG    SockstatProtocol
+
+	TCP6     SockstatProtocol
+	UDP6     SockstatProtocol
+	UDPLite6 SockstatProtocol
+	RAW6     SockstatProtocol
+	FRAG6    SockstatProtocol
+}
+
+// GetSockstat reads '/proc/net/sockstat' and '/proc/net/sockstat6'.
+func GetSockstat() (Sockstat, error) {
+	return defaultFS.GetSockstat()
+}
+
+// GetSockstat reads '$ROOT/net/sockstat' and '$ROOT/net/sockstat6'.
+func (fs FS) GetSockstat() (Sockstat, error) {
+	var st Sockstat
+	d, err := readSockstat(fs.path("net", "sockstat"))
+	if err != nil {
+		return Sockstat{}, err
+	}
+	if err = parseSockstat(d, &st); err != nil {
+		return Sockstat{}, err
+	}
+
+	// '/proc/net/sockstat6' does not exist if IPv6 is disabled
+	d, err = readSockstat(fs.path("net", "sockstat6"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return st, nil
+		}
+		return Sockstat{}, err
+	}
+	if err = parseSockstat(d, &st); err != nil {
+		return Sockstat{}, err
+	}
+	return st, nil
+}
+
+func readSockstat(fpath string) ([]byte, error) {
+	f, err := fileutil.OpenToRead(fpath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ioutil.ReadAll(f)
+}
+
+func parseSockstat(d []byte, st *Sockstat) error {
+	scanner := bufio.NewScanner(bytes.NewReader(d))
+	for scanner.Scan() {
+		ds := strings.Fields(scanner.Text())
+		if len(ds) == 0 {
+			continue
+		}
+		if len(ds)%2 != 1 || !strings.HasSuffix(ds[0], ":") {
+			return fmt.Errorf("unknown sockstat line %q", scanner.Text())
+		}
+
+		if ds[0] == "sockets:" {
+			// 'sockets: used 18'
+			if len(ds) != 3 || ds[1] != "used" {
+				return fmt.Errorf("unknown sockstat line %q", scanner.Text())
+			}
+			v, err := strconv.ParseUint(ds[2], 10, 64)
+			if err != nil {
+				return err
+			}
+			st.SocketsUsed = v
+			continue
+		}
+
+		var p *SockstatProtocol
+		switch strings.TrimSuffix(ds[0], ":") {
+		case "TCP":
+			p = &st.TCP
+		case "UDP":
+			p = &st.UDP
+		case "UDPLITE":
+			p = &st.UDPLite
+		case "RAW":
+			p = &st.RAW
+		case "FRAG":
+			p = &st.FRAG
+		case "TCP6":
+			p = &st.TCP6
+		case "UDP6":
+			p = &st.UDP6
+		case "UDPLITE6":
+			p = &st.UDPLite6
+		case "RAW6":
+			p = &st.RAW6
+		case "FRAG6":
+			p = &st.FRAG6
+		default:
+			// e.g. 'MPTCP' in newer kernels
+			continue
+		}
+		for i := 1; i < len(ds); i += 2 {
+			v, err := strconv.ParseUint(ds[i+1], 10, 64)
+			if err != nil {
+				return err
+			}
+			switch ds[i] {
+			case "inuse":
+				p.InUse = v
+			case "orphan":
+				p.Orphan = v
+			case "tw":
+				p.TW = v
+			case "alloc":
+				p.Alloc = v
+			case "mem":
+				p.Mem = v
+			case "memory":
+				p.Memory = v
+			}
+		}
+	}
+	return scanner.Err()
+}
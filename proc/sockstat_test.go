@@ -0,0 +1,89 @@
+package proc
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const testSockstat = `sockets: used 1387
+TCP: inuse 41 orphan 2 tw 318 alloc 52 mem 9
+UDP: inuse 6 mem 3
+UDPLITE: inuse 0
+RAW: inuse 1
+FRAG: inuse 0 memory 0
+MPTCP: inuse 0
+`
+
+const testSockstat6 = `TCP6: inuse 12
+UDP6: inuse 4
+UDPLITE6: inuse 0
+RAW6: inuse 1
+FRAG6: inuse 2 memory 4096
+`
+
+func TestParseSockstat(t *testing.T) {
+	var st Sockstat
+	if err := parseSockstat([]byte(testSockstat), &st); err != nil {
+		t.Fatal(err)
+	}
+	if err := parseSockstat([]byte(testSockstat6), &st); err != nil {
+		t.Fatal(err)
+	}
+	if st.SocketsUsed != 1387 {
+		t.Fatalf("SocketsUsed expected 1387, got %d", st.SocketsUsed)
+	}
+	if st.TCP.InUse != 41 || st.TCP.Orphan != 2 || st.TCP.TW != 318 || st.TCP.Alloc != 52 || st.TCP.Mem != 9 {
+		t.Fatalf("unexpected TCP %+v", st.TCP)
+	}
+	if st.UDP.InUse != 6 || st.UDP.Mem != 3 || st.RAW.InUse != 1 {
+		t.Fatalf("unexpected UDP, RAW %+v, %+v", st.UDP, st.RAW)
+	}
+	if st.TCP6.InUse != 12 || st.UDP6.InUse != 4 || st.FRAG6.InUse != 2 || st.FRAG6.Memory != 4096 {
+		t.Fatalf("unexpected sockstat6 %+v", st)
+	}
+
+	for _, txt := range []string{"TCP: inuse\n", "sockets: 18\n", "TCP: inuse x\n"} {
+		if err := parseSockstat([]byte(txt), &st); err == nil {
+			t.Fatalf("expected error for %q", txt)
+		}
+	}
+}
+
+func TestFSGetSockstat(t *testing.T) {
+	root, err := ioutil.TempDir(os.TempDir(), "proc-sockstat-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	if err = os.MkdirAll(filepath.Join(root, "net"), 0777); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(filepath.Join(root, "net", "sockstat"), []byte(testSockstat), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	fs, err := NewFS(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// no 'sockstat6', as with IPv6 disabled
+	st, err := fs.GetSockstat()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st.TCP.TW != 318 || st.TCP6.InUse != 0 {
+		t.Fatalf("unexpected sockstat %+v", st)
+	}
+}
+
+func TestGetSockstat(t *testing.T) {
+	st, err := GetSockstat()
+	if err != nil {
+		t.Skip(err)
+	}
+	fmt.Printf("%+v\n", st)
+}
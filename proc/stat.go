@@ -19,16 +19,34 @@ import (
 
 // GetStatByPID reads '/proc/$PID/stat' data.
 func GetStatByPID(pid int64) (s Stat, err error) {
+	return defaultFS.GetStatByPID(pid)
+}
+
+// GetStatByPID reads '$ROOT/$PID/stat' data.
+func (fs FS) GetStatByPID(pid int64) (s Stat, err error) {
+	return getStat(fs.pidPath(pid, "stat"))
+}
+
+// GetStatByTID reads '/proc/$PID/task/$TID/stat' data.
+func GetStatByTID(pid, tid int64) (s Stat, err error) {
+	return defaultFS.GetStatByTID(pid, tid)
+}
+
+// GetStatByTID reads '$ROOT/$PID/task/$TID/stat' data.
+func (fs FS) GetStatByTID(pid, tid int64) (s Stat, err error) {
+	return getStat(fs.taskPath(pid, tid, "stat"))
+}
+
+func getStat(fpath string) (s Stat, err error) {
 	var d []byte
-	d, err = readStat(pid)
+	d, err = readStat(fpath)
 	if err != nil {
 		return Stat{}, err
 	}
 	return parseStat(d)
 }
 
-func readStat(pid int64) ([]byte, error) {
-	fpath := fmt.Sprintf("/proc/%d/stat", pid)
+func readStat(fpath string) ([]byte, error) {
 	f, err := fileutil.OpenToRead(fpath)
 	if err != nil {
 		return nil, err
@@ -45,7 +63,7 @@ func parseStat(d []byte) (s Stat, err error) {
 			continue
 		}
 
-		fds := strings.Fields(txt)
+		fds := splitStat(txt)
 		for i, fv := range fds {
 			column := schema.ToField(StatSchema.Columns[i].Name)
 			val := reflect.ValueOf(&s).Elem()
@@ -143,6 +161,18 @@ func parseStat(d []byte) (s Stat, err error) {
 	return s, err
 }
 
+// splitStat splits the fields of 'stat', where
+// 'comm' in parentheses may contain spaces.
+func splitStat(txt string) []string {
+	i, j := strings.IndexByte(txt, '('), strings.LastIndexByte(txt, ')')
+	if i < 0 || j < i {
+		return strings.Fields(txt)
+	}
+	fds := strings.Fields(txt[:i])
+	fds = append(fds, txt[i:j+1])
+	return append(fds, strings.Fields(txt[j+1:])...)
+}
+
 const statTmpl = `
 ----------------------------------------
 [/proc/{{.Pid}}/stat]
@@ -15,3 +15,13 @@ func TestGetStatByPID(t *testing.T) {
 	}
 	fmt.Printf("GetStatByPID: %+v\n", s)
 }
+
+func TestParseStatCommWithSpaces(t *testing.T) {
+	s, err := parseStat([]byte("43 (GC Thread#0) S 1 42 42 0 -1 4194624 1 0 0 0 5 6 0 0 20 0 30 0 4302 24027136 1319 18446744073709551615 0 0 0 0 0 0 0 0 0 0 0 0 -1 3 0 0 0 0 0 0 0 0 0 0 0 0 0\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Comm != "GC Thread#0" || s.Utime != 5 || s.Stime != 6 || s.Processor != 3 {
+		t.Fatalf("unexpected stat %+v", s)
+	}
+}
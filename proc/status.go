@@ -2,7 +2,6 @@ package proc
 
 import (
 	"bytes"
-	"fmt"
 	"io/ioutil"
 	"log"
 	"strings"
@@ -16,7 +15,26 @@ import (
 
 // GetStatusByPID reads '/proc/$PID/status' data.
 func GetStatusByPID(pid int64) (s Status, err error) {
-	d, derr := readStatus(pid)
+	return defaultFS.GetStatusByPID(pid)
+}
+
+// GetStatusByPID reads '$ROOT/$PID/status' data.
+func (fs FS) GetStatusByPID(pid int64) (s Status, err error) {
+	return getStatus(fs.pidPath(pid, "status"))
+}
+
+// GetStatusByTID reads '/proc/$PID/task/$TID/status' data.
+func GetStatusByTID(pid, tid int64) (s Status, err error) {
+	return defaultFS.GetStatusByTID(pid, tid)
+}
+
+// GetStatusByTID reads '$ROOT/$PID/task/$TID/status' data.
+func (fs FS) GetStatusByTID(pid, tid int64) (s Status, err error) {
+	return getStatus(fs.taskPath(pid, tid, "status"))
+}
+
+func getStatus(fpath string) (s Status, err error) {
+	d, derr := readStatus(fpath)
 	if derr != nil {
 		return Status{}, derr
 	}
@@ -27,54 +45,53 @@ func GetStatusByPID(pid int64) (s Status, err error) {
 
 	s.StateParsedStatus = strings.TrimSpace(s.State)
 
-	u, _ := humanize.ParseBytes(s.VmPeak)
+	u, _ := parseKibibytes(s.VmPeak)
 	s.VmPeakBytesN = u
 	s.VmPeakParsedBytes = humanize.Bytes(u)
-	u, _ = humanize.ParseBytes(s.VmSize)
+	u, _ = parseKibibytes(s.VmSize)
 	s.VmSizeBytesN = u
 	s.VmSizeParsedBytes = humanize.Bytes(u)
-	u, _ = humanize.ParseBytes(s.VmLck)
+	u, _ = parseKibibytes(s.VmLck)
 	s.VmLckBytesN = u
 	s.VmLckParsedBytes = humanize.Bytes(u)
-	u, _ = humanize.ParseBytes(s.VmPin)
+	u, _ = parseKibibytes(s.VmPin)
 	s.VmPinBytesN = u
 	s.VmPinParsedBytes = humanize.Bytes(u)
-	u, _ = humanize.ParseBytes(s.VmHWM)
+	u, _ = parseKibibytes(s.VmHWM)
 	s.VmHWMBytesN = u
 	s.VmHWMParsedBytes = humanize.Bytes(u)
-	u, _ = humanize.ParseBytes(s.VmRSS)
+	u, _ = parseKibibytes(s.VmRSS)
 	s.VmRSSBytesN = u
 	s.VmRSSParsedBytes = humanize.Bytes(u)
-	u, _ = humanize.ParseBytes(s.VmData)
+	u, _ = parseKibibytes(s.VmData)
 	s.VmDataBytesN = u
 	s.VmDataParsedBytes = humanize.Bytes(u)
-	u, _ = humanize.ParseBytes(s.VmStk)
+	u, _ = parseKibibytes(s.VmStk)
 	s.VmStkBytesN = u
 	s.VmStkParsedBytes = humanize.Bytes(u)
-	u, _ = humanize.ParseBytes(s.VmExe)
+	u, _ = parseKibibytes(s.VmExe)
 	s.VmExeBytesN = u
 	s.VmExeParsedBytes = humanize.Bytes(u)
-	u, _ = humanize.ParseBytes(s.VmLib)
+	u, _ = parseKibibytes(s.VmLib)
 	s.VmLibBytesN = u
 	s.VmLibParsedBytes = humanize.Bytes(u)
-	u, _ = humanize.ParseBytes(s.VmPTE)
+	u, _ = parseKibibytes(s.VmPTE)
 	s.VmPTEBytesN = u
 	s.VmPTEParsedBytes = humanize.Bytes(u)
-	u, _ = humanize.ParseBytes(s.VmPMD)
+	u, _ = parseKibibytes(s.VmPMD)
 	s.VmPMDBytesN = u
 	s.VmPMDParsedBytes = humanize.Bytes(u)
-	u, _ = humanize.ParseBytes(s.VmSwap)
+	u, _ = parseKibibytes(s.VmSwap)
 	s.VmSwapBytesN = u
 	s.VmSwapParsedBytes = humanize.Bytes(u)
-	u, _ = humanize.ParseBytes(s.HugetlbPages)
+	u, _ = parseKibibytes(s.HugetlbPages)
 	s.HugetlbPagesBytesN = u
 	s.HugetlbPagesParsedBytes = humanize.Bytes(u)
 
 	return s, nil
 }
 
-func readStatus(pid int64) ([]byte, error) {
-	fpath := fmt.Sprintf("/proc/%d/status", pid)
+func readStatus(fpath string) ([]byte, error) {
 	f, err := fileutil.OpenToRead(fpath)
 	if err != nil {
 		return nil, err
@@ -163,8 +180,13 @@ func (s Status) String() string {
 
 // GetProgram returns the program name.
 func GetProgram(pid int64) (string, error) {
+	return defaultFS.GetProgram(pid)
+}
+
+// GetProgram returns the program name.
+func (fs FS) GetProgram(pid int64) (string, error) {
 	// Readlink needs root permission
 	// return os.Readlink(fmt.Sprintf("/proc/%d/exe", pid))
-	s, err := GetStatusByPID(pid)
+	s, err := fs.GetStatusByPID(pid)
 	return s.Name, err
 }
@@ -0,0 +1,197 @@
+package proc
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/gyuho/linux-inspect/pkg/fileutil"
+	"github.com/gyuho/linux-inspect/schema"
+)
+
+// GetSystemStat reads '/proc/stat'.
+func GetSystemStat() (SystemStat, error) {
+	return defaultFS.GetSystemStat()
+}
+
+// GetSystemStat reads '$ROOT/stat'.
+func (fs FS) GetSystemStat() (SystemStat, error) {
+	f, err := fileutil.OpenToRead(fs.path("stat"))
+	if err != nil {
+		return SystemStat{}, err
+	}
+	defer f.Close()
+
+	d, err := ioutil.ReadAll(f)
+	if err != nil {
+		return SystemStat{}, err
+	}
+	return parseSystemStat(d)
+}
+
+func parseSystemStat(d []byte) (ss SystemStat, err error) {
+	found := false
+	scanner := bufio.NewScanner(bytes.NewReader(d))
+	for scanner.Scan() {
+		txt := scanner.Text()
+		if len(txt) == 0 {
+			continue
+		}
+		fs := strings.Fields(txt)
+		if len(fs) < 2 {
+			return SystemStat{}, fmt.Errorf("not enough columns at %v", fs)
+		}
+
+		if strings.HasPrefix(fs[0], "cpu") {
+			c, cerr := parseCPUStat(fs)
+			if cerr != nil {
+				return SystemStat{}, cerr
+			}
+			if c.Name == "cpu" {
+				ss.CPU = c
+				found = true
+			} else {
+				ss.CPUs = append(ss.CPUs, c)
+			}
+			continue
+		}
+
+		var target *uint64
+		switch fs[0] {
+		case "intr":
+			target = &ss.Intr
+		case "ctxt":
+			target = &ss.Ctxt
+		case "btime":
+			target = &ss.Btime
+		case "processes":
+			target = &ss.Processes
+		case "procs_running":
+			target = &ss.ProcsRunning
+		case "procs_blocked":
+			target = &ss.ProcsBlocked
+		case "softirq":
+			target = &ss.Softirq
+		default:
+			continue
+		}
+		// 'intr' and 'softirq' are followed by per-source counts; take the total
+		v, perr := strconv.ParseUint(fs[1], 10, 64)
+		if perr != nil {
+			return SystemStat{}, fmt.Errorf("%v when parsing %s %v", perr, fs[0], fs[1])
+		}
+		*target = v
+	}
+	if err = scanner.Err(); err != nil {
+		return SystemStat{}, err
+	}
+	if !found {
+		return SystemStat{}, fmt.Errorf("'cpu' line not found")
+	}
+	return ss, nil
+}
+
+// parseCPUStat parses a 'cpu' line, where older kernels
+// may not have all columns (e.g. 'steal', 'guest').
+func parseCPUStat(fs []string) (c CPUStat, err error) {
+	if len(fs) > len(CPUStatSchema.Columns) {
+		fs = fs[:len(CPUStatSchema.Columns)]
+	}
+	val := reflect.ValueOf(&c).Elem()
+	for i, fv := range fs {
+		column := schema.ToField(CPUStatSchema.Columns[i].Name)
+		f := val.FieldByName(column)
+		if !f.IsValid() || !f.CanSet() {
+			continue
+		}
+		switch CPUStatSchema.Columns[i].Kind {
+		case reflect.Uint64:
+			uv, uerr := strconv.ParseUint(fv, 10, 64)
+			if uerr != nil {
+				return CPUStat{}, fmt.Errorf("%v when parsing %s %v", uerr, column, fv)
+			}
+			f.SetUint(uv)
+		case reflect.String:
+			f.SetString(fv)
+		}
+	}
+	return c, nil
+}
+
+// Total returns the total number of clock ticks of the CPU.
+// 'guest' and 'guest_nice' are excluded, since they are
+// already accounted in 'user' and 'nice'.
+func (c CPUStat) Total() uint64 {
+	return c.User + c.Nice + c.System + c.Idle + c.Iowait + c.Irq + c.Softirq + c.Steal
+}
+
+// CPUUsage is the CPU utilization between two '/proc/stat' snapshots.
+// All values are in percent of the elapsed CPU time.
+type CPUUsage struct {
+	Name string
+
+	User    float64
+	Nice    float64
+	System  float64
+	Idle    float64
+	Iowait  float64
+	Irq     float64
+	Softirq float64
+	Steal   float64
+
+	// Busy is the non-idle time (100 - Idle - Iowait).
+	Busy float64
+}
+
+// DeltaSystemStat computes the CPU utilization between two snapshots.
+// It returns the aggregate usage and the per-CPU usage.
+// CPUs that do not exist in both snapshots are skipped
+// (e.g. CPU hotplug).
+func DeltaSystemStat(prev, cur SystemStat) (total CPUUsage, perCPU []CPUUsage) {
+	total = deltaCPUStat(prev.CPU, cur.CPU)
+
+	pm := make(map[string]CPUStat, len(prev.CPUs))
+	for _, c := range prev.CPUs {
+		pm[c.Name] = c
+	}
+	perCPU = make([]CPUUsage, 0, len(cur.CPUs))
+	for _, c := range cur.CPUs {
+		p, ok := pm[c.Name]
+		if !ok {
+			continue
+		}
+		perCPU = append(perCPU, deltaCPUStat(p, c))
+	}
+	return total, perCPU
+}
+
+func deltaCPUStat(prev, cur CPUStat) CPUUsage {
+	u := CPUUsage{Name: cur.Name}
+	if cur.Total() <= prev.Total() {
+		return u
+	}
+	elapsed := float64(cur.Total() - prev.Total())
+	pct := func(p, c uint64) float64 {
+		if c < p {
+			return 0
+		}
+		return float64(c-p) / elapsed * 100
+	}
+	u.User = pct(prev.User, cur.User)
+	u.Nice = pct(prev.Nice, cur.Nice)
+	u.System = pct(prev.System, cur.System)
+	u.Idle = pct(prev.Idle, cur.Idle)
+	u.Iowait = pct(prev.Iowait, cur.Iowait)
+	u.Irq = pct(prev.Irq, cur.Irq)
+	u.Softirq = pct(prev.Softirq, cur.Softirq)
+	u.Steal = pct(prev.Steal, cur.Steal)
+	u.Busy = 100 - u.Idle - u.Iowait
+	if u.Busy < 0 {
+		u.Busy = 0
+	}
+	return u
+}
@@ -0,0 +1,75 @@
+package proc
+
+import (
+	"fmt"
+	"testing"
+)
+
+const testProcStat = `cpu  10132153 290696 3084719 46828483 16683 0 25195 0 175628 0
+cpu0 1393280 32966 572056 13343292 6130 0 17875 0 23933 0
+cpu1 1335498 33014 456548 10824012 3547 0 2853 0 24188 0
+intr 199292651 0 21 0 0 0 0 0 0 1 0 0 0 0 0 0
+ctxt 436316815
+btime 1514392853
+processes 154227
+procs_running 2
+procs_blocked 1
+softirq 80138209 5 30278393 53 2437014 74302 0 16418 28217549 0 19114475
+`
+
+const testProcStatNext = `cpu  10132253 290696 3084769 46828533 16683 0 25195 0 175628 0
+cpu0 1393330 32966 572081 13343317 6130 0 17875 0 23933 0
+cpu1 1335548 33014 456573 10824037 3547 0 2853 0 24188 0
+intr 199292751 0 21 0 0 0 0 0 0 1 0 0 0 0 0 0
+ctxt 436316915
+btime 1514392853
+processes 154228
+procs_running 1
+procs_blocked 0
+softirq 80138309 5 30278393 53 2437014 74302 0 16418 28217549 0 19114475
+`
+
+func TestParseSystemStat(t *testing.T) {
+	ss, err := parseSystemStat([]byte(testProcStat))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ss.CPU.Name != "cpu" || ss.CPU.User != 10132153 || ss.CPU.Guest != 175628 {
+		t.Fatalf("unexpected aggregate cpu %+v", ss.CPU)
+	}
+	if len(ss.CPUs) != 2 || ss.CPUs[1].Name != "cpu1" || ss.CPUs[1].Idle != 10824012 {
+		t.Fatalf("unexpected per-cpu %+v", ss.CPUs)
+	}
+	if ss.Intr != 199292651 || ss.Softirq != 80138209 {
+		t.Fatalf("unexpected intr %d, softirq %d", ss.Intr, ss.Softirq)
+	}
+	if ss.Ctxt != 436316815 || ss.Btime != 1514392853 || ss.Processes != 154227 {
+		t.Fatalf("unexpected %+v", ss)
+	}
+	if ss.ProcsRunning != 2 || ss.ProcsBlocked != 1 {
+		t.Fatalf("unexpected procs running %d, blocked %d", ss.ProcsRunning, ss.ProcsBlocked)
+	}
+
+	next, err := parseSystemStat([]byte(testProcStatNext))
+	if err != nil {
+		t.Fatal(err)
+	}
+	total, perCPU := DeltaSystemStat(ss, next)
+	if total.User != 50 || total.System != 25 || total.Idle != 25 || total.Busy != 75 {
+		t.Fatalf("unexpected total usage %+v", total)
+	}
+	if len(perCPU) != 2 || perCPU[0].Name != "cpu0" || perCPU[0].User != 50 {
+		t.Fatalf("unexpected per-cpu usage %+v", perCPU)
+	}
+}
+
+func TestGetSystemStat(t *testing.T) {
+	ss, err := GetSystemStat()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ss.CPUs) == 0 {
+		t.Fatalf("expected per-cpu stats, got %+v", ss)
+	}
+	fmt.Printf("GetSystemStat: %+v\n", ss)
+}
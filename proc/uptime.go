@@ -11,7 +11,12 @@ import (
 
 // GetUptime reads '/proc/uptime'.
 func GetUptime() (Uptime, error) {
-	f, err := fileutil.OpenToRead("/proc/uptime")
+	return defaultFS.GetUptime()
+}
+
+// GetUptime reads '$ROOT/uptime'.
+func (fs FS) GetUptime() (Uptime, error) {
+	f, err := fileutil.OpenToRead(fs.path("uptime"))
 	if err != nil {
 		return Uptime{}, err
 	}
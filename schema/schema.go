@@ -124,6 +124,8 @@ func ToField(s string) string {
 	s = strings.Replace(s, "-", "_", -1)
 	s = strings.Replace(s, "/", "", -1)
 	s = strings.Replace(s, ">", "", -1)
+	s = strings.Replace(s, "(", "_", -1) // 'Active(anon)'
+	s = strings.Replace(s, ")", "", -1)
 	cs := strings.Split(s, "_")
 	var ss []string
 	for _, v := range cs {
@@ -139,6 +141,8 @@ func ToFieldTag(s string) string {
 	s = strings.ToLower(s)
 	s = strings.Replace(s, "-", "_", -1)
 	s = strings.Replace(s, "/", "", -1)
+	s = strings.Replace(s, "(", "_", -1)
+	s = strings.Replace(s, ")", "", -1)
 	return strings.Replace(s, ">", "", -1)
 }
 
@@ -7,6 +7,7 @@ if ! [[ "$0" =~ "scripts/update-schema.sh" ]]; then
   exit 255
 fi
 
+go run ./cmd/generate-cgroup/main.go
 go run ./cmd/generate-df/main.go
 go run ./cmd/generate-etc/main.go
 go run ./cmd/generate-proc/main.go
@@ -0,0 +1,3 @@
+// Package sockdiag queries sockets with Linux 'NETLINK_SOCK_DIAG'.
+// Reference http://man7.org/linux/man-pages/man7/sock_diag.7.html.
+package sockdiag
@@ -0,0 +1,243 @@
+package sockdiag
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"os"
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// https://github.com/torvalds/linux/blob/master/include/uapi/linux/sock_diag.h
+// https://github.com/torvalds/linux/blob/master/include/uapi/linux/inet_diag.h
+const (
+	sockDiagByFamily = 20 // SOCK_DIAG_BY_FAMILY
+	inetDiagInfo     = 2  // INET_DIAG_INFO
+
+	sizeofInetDiagSockID = 48
+	sizeofInetDiagReqV2  = 8 + sizeofInetDiagSockID
+	sizeofInetDiagMsg    = 4 + sizeofInetDiagSockID + 20
+
+	// allStates matches sockets in every state.
+	allStates = 0xffffffff
+)
+
+// Entry is a socket from 'inet_diag_msg'.
+type Entry struct {
+	Family   uint8 // 'unix.AF_INET' or 'unix.AF_INET6'
+	Protocol uint8 // 'unix.IPPROTO_TCP' or 'unix.IPPROTO_UDP'
+	State    uint8
+
+	Timer   uint8
+	Retrans uint8
+
+	SrcIP   net.IP
+	SrcPort uint16
+	DstIP   net.IP
+	DstPort uint16
+
+	Interface uint32
+	Expires   uint32
+	RQueue    uint32
+	WQueue    uint32
+	UID       uint32
+	Inode     uint32
+
+	// TCPInfo is only available for TCP sockets.
+	TCPInfo *TCPInfo
+}
+
+// https://github.com/torvalds/linux/blob/master/include/net/tcp_states.h
+var tcpStates = map[uint8]string{
+	1:  "ESTABLISHED",
+	2:  "SYN_SENT",
+	3:  "SYN_RECV",
+	4:  "FIN_WAIT1",
+	5:  "FIN_WAIT2",
+	6:  "TIME_WAIT",
+	7:  "CLOSE",
+	8:  "CLOSE_WAIT",
+	9:  "LAST_ACK",
+	10: "LISTEN",
+	11: "CLOSING",
+	12: "NEW_SYN_RECV",
+}
+
+// StateString returns the state name, as in '/proc/net/tcp'.
+// UDP sockets reuse TCP states ('CLOSE' is unconnected).
+func (e Entry) StateString() string {
+	return tcpStates[e.State]
+}
+
+// GetTCP returns all TCP sockets of the family
+// ('unix.AF_INET' or 'unix.AF_INET6').
+func GetTCP(family uint8) ([]Entry, error) {
+	return Get(family, unix.IPPROTO_TCP)
+}
+
+// GetUDP returns all UDP sockets of the family
+// ('unix.AF_INET' or 'unix.AF_INET6').
+func GetUDP(family uint8) ([]Entry, error) {
+	return Get(family, unix.IPPROTO_UDP)
+}
+
+// Get dumps all sockets of the family and protocol, in the
+// network namespace of the current process. It returns an error
+// if 'NETLINK_SOCK_DIAG' is not available (e.g. restricted by
+// seccomp, or kernel < 3.3).
+func Get(family, protocol uint8) ([]Entry, error) {
+	fd, err := unix.Socket(unix.AF_NETLINK, unix.SOCK_DGRAM|unix.SOCK_CLOEXEC, unix.NETLINK_SOCK_DIAG)
+	if err != nil {
+		return nil, os.NewSyscallError("socket", err)
+	}
+	defer unix.Close(fd)
+
+	if err = unix.Sendto(fd, newRequest(family, protocol), 0, &unix.SockaddrNetlink{Family: unix.AF_NETLINK}); err != nil {
+		return nil, os.NewSyscallError("sendto", err)
+	}
+
+	var ents []Entry
+	buf := make([]byte, 8*os.Getpagesize())
+	for {
+		n, _, err := unix.Recvfrom(fd, buf, 0)
+		if err != nil {
+			return nil, os.NewSyscallError("recvfrom", err)
+		}
+		es, done, err := parseMessages(buf[:n], protocol)
+		if err != nil {
+			return nil, err
+		}
+		ents = append(ents, es...)
+		if done {
+			return ents, nil
+		}
+	}
+}
+
+// newRequest returns 'nlmsghdr' followed by 'inet_diag_req_v2'.
+func newRequest(family, protocol uint8) []byte {
+	b := make([]byte, unix.SizeofNlMsghdr+sizeofInetDiagReqV2)
+	nativeEndian.PutUint32(b[0:4], uint32(len(b)))
+	nativeEndian.PutUint16(b[4:6], sockDiagByFamily)
+	nativeEndian.PutUint16(b[6:8], unix.NLM_F_REQUEST|unix.NLM_F_DUMP)
+	nativeEndian.PutUint32(b[8:12], 1) // sequence number
+
+	req := b[unix.SizeofNlMsghdr:]
+	req[0] = family
+	req[1] = protocol
+	req[2] = 1 << (inetDiagInfo - 1) // request 'tcp_info'
+	nativeEndian.PutUint32(req[4:8], allStates)
+	return b
+}
+
+// parseMessages parses netlink messages in one datagram.
+// It returns true when it reaches 'NLMSG_DONE'.
+func parseMessages(b []byte, protocol uint8) (ents []Entry, done bool, err error) {
+	for len(b) >= unix.SizeofNlMsghdr {
+		l := int(nativeEndian.Uint32(b[0:4]))
+		tp := nativeEndian.Uint16(b[4:6])
+		if l < unix.SizeofNlMsghdr || l > len(b) {
+			return nil, false, fmt.Errorf("invalid netlink message length %d (%d bytes left)", l, len(b))
+		}
+		data := b[unix.SizeofNlMsghdr:l]
+
+		switch tp {
+		case unix.NLMSG_DONE:
+			return ents, true, nil
+
+		case unix.NLMSG_ERROR:
+			if len(data) < 4 {
+				return nil, false, fmt.Errorf("not enough bytes for netlink error %v", data)
+			}
+			if errno := int32(nativeEndian.Uint32(data[0:4])); errno != 0 {
+				return nil, false, os.NewSyscallError("netlink", syscall.Errno(-errno))
+			}
+
+		case sockDiagByFamily:
+			ent, err := parseInetDiagMsg(data, protocol)
+			if err != nil {
+				return nil, false, err
+			}
+			ents = append(ents, ent)
+		}
+
+		if l = align(l); l > len(b) {
+			break
+		}
+		b = b[l:]
+	}
+	return ents, false, nil
+}
+
+// parseInetDiagMsg parses 'inet_diag_msg' and its attributes.
+// Ports and addresses are in network byte order.
+func parseInetDiagMsg(d []byte, protocol uint8) (Entry, error) {
+	if len(d) < sizeofInetDiagMsg {
+		return Entry{}, fmt.Errorf("not enough bytes for inet_diag_msg %d", len(d))
+	}
+	ent := Entry{
+		Family:   d[0],
+		Protocol: protocol,
+		State:    d[1],
+		Timer:    d[2],
+		Retrans:  d[3],
+	}
+
+	id := d[4 : 4+sizeofInetDiagSockID]
+	ent.SrcPort = binary.BigEndian.Uint16(id[0:2])
+	ent.DstPort = binary.BigEndian.Uint16(id[2:4])
+	ent.SrcIP = parseIP(ent.Family, id[4:20])
+	ent.DstIP = parseIP(ent.Family, id[20:36])
+	ent.Interface = nativeEndian.Uint32(id[36:40])
+
+	m := d[4+sizeofInetDiagSockID:]
+	ent.Expires = nativeEndian.Uint32(m[0:4])
+	ent.RQueue = nativeEndian.Uint32(m[4:8])
+	ent.WQueue = nativeEndian.Uint32(m[8:12])
+	ent.UID = nativeEndian.Uint32(m[12:16])
+	ent.Inode = nativeEndian.Uint32(m[16:20])
+
+	// rtattr
+	attrs := d[sizeofInetDiagMsg:]
+	for len(attrs) >= 4 {
+		l := int(nativeEndian.Uint16(attrs[0:2]))
+		tp := nativeEndian.Uint16(attrs[2:4])
+		if l < 4 || l > len(attrs) {
+			break
+		}
+		if tp == inetDiagInfo && protocol == unix.IPPROTO_TCP {
+			info := parseTCPInfo(attrs[4:l])
+			ent.TCPInfo = &info
+		}
+		if l = align(l); l > len(attrs) {
+			break
+		}
+		attrs = attrs[l:]
+	}
+	return ent, nil
+}
+
+func parseIP(family uint8, b []byte) net.IP {
+	if family == unix.AF_INET {
+		return net.IPv4(b[0], b[1], b[2], b[3])
+	}
+	ip := make(net.IP, net.IPv6len)
+	copy(ip, b[:net.IPv6len])
+	return ip
+}
+
+// align rounds up to 'NLMSG_ALIGNTO' (same as 'RTA_ALIGNTO').
+func align(l int) int {
+	return (l + 3) &^ 3
+}
+
+var nativeEndian binary.ByteOrder = func() binary.ByteOrder {
+	x := uint16(1)
+	if *(*byte)(unsafe.Pointer(&x)) == 1 {
+		return binary.LittleEndian
+	}
+	return binary.BigEndian
+}()
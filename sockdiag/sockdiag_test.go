@@ -0,0 +1,115 @@
+package sockdiag
+
+import (
+	"fmt"
+	"net"
+	"testing"
+
+	"golang.org/x/sys/unix"
+)
+
+func TestGetTCP(t *testing.T) {
+	ln, err := net.Listen("tcp4", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	lport := uint16(ln.Addr().(*net.TCPAddr).Port)
+
+	donec := make(chan struct{})
+	go func() {
+		defer close(donec)
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		b := make([]byte, 5)
+		conn.Read(b)
+		conn.Write(b)
+		conn.Read(b) // wait for client to close
+	}()
+
+	conn, err := net.Dial("tcp4", ln.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = conn.Write([]byte("hello")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = conn.Read(make([]byte, 5)); err != nil {
+		t.Fatal(err)
+	}
+	cport := uint16(conn.LocalAddr().(*net.TCPAddr).Port)
+
+	ents, err := GetTCP(unix.AF_INET)
+	conn.Close()
+	<-donec
+	if err != nil {
+		t.Skipf("NETLINK_SOCK_DIAG is not available (%v)", err)
+	}
+
+	var listener, client *Entry
+	for i := range ents {
+		switch {
+		case ents[i].SrcPort == lport && ents[i].StateString() == "LISTEN":
+			listener = &ents[i]
+		case ents[i].SrcPort == cport && ents[i].DstPort == lport:
+			client = &ents[i]
+		}
+	}
+	if listener == nil {
+		t.Fatalf("listener on port %d not found in %d sockets", lport, len(ents))
+	}
+	if !listener.SrcIP.Equal(net.ParseIP("127.0.0.1")) || listener.Inode == 0 {
+		t.Fatalf("unexpected listener %+v", listener)
+	}
+	if client == nil {
+		t.Fatalf("client on port %d not found in %d sockets", cport, len(ents))
+	}
+	if client.StateString() != "ESTABLISHED" {
+		t.Fatalf("client state expected ESTABLISHED, got %q", client.StateString())
+	}
+	if client.TCPInfo == nil {
+		t.Fatalf("client expected tcp_info, got %+v", client)
+	}
+	if client.TCPInfo.SndCwnd == 0 || client.TCPInfo.RTT == 0 {
+		t.Fatalf("unexpected tcp_info %+v", client.TCPInfo)
+	}
+	fmt.Printf("GetTCP: %+v %+v\n", client, client.TCPInfo)
+}
+
+func TestGetUDP(t *testing.T) {
+	conn, err := net.ListenPacket("udp6", "[::1]:0")
+	if err != nil {
+		t.Skip(err)
+	}
+	defer conn.Close()
+	port := uint16(conn.LocalAddr().(*net.UDPAddr).Port)
+
+	ents, err := GetUDP(unix.AF_INET6)
+	if err != nil {
+		t.Skipf("NETLINK_SOCK_DIAG is not available (%v)", err)
+	}
+	for _, ent := range ents {
+		if ent.SrcPort != port {
+			continue
+		}
+		if !ent.SrcIP.Equal(net.IPv6loopback) || ent.TCPInfo != nil {
+			t.Fatalf("unexpected UDP socket %+v", ent)
+		}
+		return
+	}
+	t.Fatalf("UDP socket on port %d not found in %d sockets", port, len(ents))
+}
+
+func TestParseMessagesError(t *testing.T) {
+	b := make([]byte, unix.SizeofNlMsghdr+4)
+	nativeEndian.PutUint32(b[0:4], uint32(len(b)))
+	nativeEndian.PutUint16(b[4:6], unix.NLMSG_ERROR)
+	errno := -int32(unix.EPERM)
+	nativeEndian.PutUint32(b[16:20], uint32(errno))
+	if _, _, err := parseMessages(b, unix.IPPROTO_TCP); err == nil {
+		t.Fatal("expected error")
+	}
+}
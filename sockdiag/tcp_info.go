@@ -0,0 +1,156 @@
+package sockdiag
+
+// TCPInfo is 'struct tcp_info' from 'INET_DIAG_INFO'.
+// Times are in microseconds, unless noted otherwise.
+// Fields not supported by the running kernel are zero.
+// Reference https://github.com/torvalds/linux/blob/master/include/uapi/linux/tcp.h.
+type TCPInfo struct {
+	State       uint8
+	CAState     uint8
+	Retransmits uint8
+	Probes      uint8
+	Backoff     uint8
+	Options     uint8
+
+	RTO    uint32
+	ATO    uint32
+	SndMSS uint32
+	RcvMSS uint32
+
+	Unacked uint32
+	Sacked  uint32
+	Lost    uint32
+	Retrans uint32
+	Fackets uint32
+
+	// in milliseconds
+	LastDataSent uint32
+	LastAckSent  uint32
+	LastDataRecv uint32
+	LastAckRecv  uint32
+
+	PMTU        uint32
+	RcvSsthresh uint32
+	RTT         uint32
+	RTTVar      uint32
+	SndSsthresh uint32
+	SndCwnd     uint32
+	AdvMSS      uint32
+	Reordering  uint32
+
+	RcvRTT   uint32
+	RcvSpace uint32
+
+	TotalRetrans uint32
+
+	// in bytes per second
+	PacingRate    uint64
+	MaxPacingRate uint64
+
+	BytesAcked    uint64
+	BytesReceived uint64
+	SegsOut       uint32
+	SegsIn        uint32
+
+	NotsentBytes uint32
+	MinRTT       uint32
+	DataSegsIn   uint32
+	DataSegsOut  uint32
+
+	// in bytes per second
+	DeliveryRate uint64
+
+	BusyTime      uint64
+	RwndLimited   uint64
+	SndbufLimited uint64
+
+	Delivered   uint32
+	DeliveredCE uint32
+
+	BytesSent    uint64
+	BytesRetrans uint64
+	DSACKDups    uint32
+	ReordSeen    uint32
+}
+
+// sizeofTCPInfo is the size of 'tcp_info' up to 'tcpi_reord_seen' (4.19).
+const sizeofTCPInfo = 224
+
+// parseTCPInfo parses 'tcp_info'. Older kernels return shorter
+// structs, and newer kernels append fields, so it is zero-padded
+// or truncated to 'sizeofTCPInfo'.
+func parseTCPInfo(b []byte) TCPInfo {
+	if len(b) < sizeofTCPInfo {
+		nb := make([]byte, sizeofTCPInfo)
+		copy(nb, b)
+		b = nb
+	}
+	u32 := func(off int) uint32 { return nativeEndian.Uint32(b[off : off+4]) }
+	u64 := func(off int) uint64 { return nativeEndian.Uint64(b[off : off+8]) }
+
+	return TCPInfo{
+		State:       b[0],
+		CAState:     b[1],
+		Retransmits: b[2],
+		Probes:      b[3],
+		Backoff:     b[4],
+		Options:     b[5],
+
+		RTO:    u32(8),
+		ATO:    u32(12),
+		SndMSS: u32(16),
+		RcvMSS: u32(20),
+
+		Unacked: u32(24),
+		Sacked:  u32(28),
+		Lost:    u32(32),
+		Retrans: u32(36),
+		Fackets: u32(40),
+
+		LastDataSent: u32(44),
+		LastAckSent:  u32(48),
+		LastDataRecv: u32(52),
+		LastAckRecv:  u32(56),
+
+		PMTU:        u32(60),
+		RcvSsthresh: u32(64),
+		RTT:         u32(68),
+		RTTVar:      u32(72),
+		SndSsthresh: u32(76),
+		SndCwnd:     u32(80),
+		AdvMSS:      u32(84),
+		Reordering:  u32(88),
+
+		RcvRTT:   u32(92),
+		RcvSpace: u32(96),
+
+		TotalRetrans: u32(100),
+
+		PacingRate:    u64(104),
+		MaxPacingRate: u64(112),
+
+		BytesAcked:    u64(120),
+		BytesReceived: u64(128),
+		SegsOut:       u32(136),
+		SegsIn:        u32(140),
+
+		NotsentBytes: u32(144),
+		MinRTT:       u32(148),
+		DataSegsIn:   u32(152),
+		DataSegsOut:  u32(156),
+
+		DeliveryRate: u64(160),
+
+		BusyTime:      u64(168),
+		RwndLimited:   u64(176),
+		SndbufLimited: u64(184),
+
+		Delivered:   u32(192),
+		DeliveredCE: u32(196),
+
+		BytesSent:    u64(200),
+		BytesRetrans: u64(208),
+		DSACKDups:    u32(216),
+		ReordSeen:    u32(220),
+	}
+}
@@ -0,0 +1,20 @@
+package sockdiag
+
+import "testing"
+
+func TestParseTCPInfo(t *testing.T) {
+	// 'tcp_info' of 3.x kernels ends at 'tcpi_total_retrans'
+	b := make([]byte, 104)
+	b[0] = 1 // ESTABLISHED
+	nativeEndian.PutUint32(b[68:72], 1500)
+	nativeEndian.PutUint32(b[80:84], 10)
+	nativeEndian.PutUint32(b[100:104], 3)
+
+	info := parseTCPInfo(b)
+	if info.State != 1 || info.RTT != 1500 || info.SndCwnd != 10 || info.TotalRetrans != 3 {
+		t.Fatalf("unexpected tcp_info %+v", info)
+	}
+	if info.BytesAcked != 0 || info.DeliveryRate != 0 {
+		t.Fatalf("missing fields expected 0, got %+v", info)
+	}
+}
@@ -0,0 +1,3 @@
+// Package sysfs represents Linux '/sys'.
+// Reference https://www.kernel.org/doc/Documentation/ABI/testing/sysfs-class-net.
+package sysfs
@@ -0,0 +1,39 @@
+package sysfs
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// DefaultRoot is the default sysfs mount point.
+const DefaultRoot = "/sys"
+
+// FS represents a sysfs tree mounted at a root path.
+// Use this to read captured fixture trees.
+type FS struct {
+	root string
+}
+
+// defaultFS reads from '/sys'; package-level readers wrap this.
+var defaultFS = FS{root: DefaultRoot}
+
+// NewFS returns a new FS rooted at the given path.
+func NewFS(root string) (FS, error) {
+	if root == "" {
+		root = DefaultRoot
+	}
+	if _, err := os.Stat(root); err != nil {
+		return FS{}, err
+	}
+	return FS{root: filepath.Clean(root)}, nil
+}
+
+// Root returns the sysfs root path.
+func (fs FS) Root() string {
+	return fs.root
+}
+
+// path returns '$ROOT/$ELEM...'.
+func (fs FS) path(elem ...string) string {
+	return filepath.Join(append([]string{fs.root}, elem...)...)
+}
@@ -0,0 +1,177 @@
+package sysfs
+
+import (
+	"io/ioutil"
+	"os"
+)
+
+// SpeedUnknown is the 'speed' of links that do not report one
+// (e.g. 'lo', 'veth', 'dummy', or links without carrier).
+const SpeedUnknown = -1
+
+// NetInterface represents '/sys/class/net/$IFACE'.
+type NetInterface struct {
+	Name string
+
+	// OperState is RFC 2863 operational state
+	// (e.g. 'up', 'down', 'unknown' for 'lo' and 'dummy').
+	OperState string
+	MTU       uint64
+	// Speed is the link speed in Mb/s, or 'SpeedUnknown'.
+	Speed  int64
+	Duplex string
+
+	Address        string
+	CarrierChanges uint64
+	// Type is the 'ARPHRD_*' hardware type
+	// (e.g. 1 for ethernet, 772 for loopback).
+	Type uint64
+
+	Statistics NetStatistics
+}
+
+// NetStatistics represents '/sys/class/net/$IFACE/statistics'.
+type NetStatistics struct {
+	RxBytes      uint64
+	RxPackets    uint64
+	RxErrors     uint64
+	RxDropped    uint64
+	RxCompressed uint64
+	RxNohandler  uint64
+
+	RxCRCErrors    uint64
+	RxFIFOErrors   uint64
+	RxFrameErrors  uint64
+	RxLengthErrors uint64
+	RxMissedErrors uint64
+	RxOverErrors   uint64
+
+	TxBytes      uint64
+	TxPackets    uint64
+	TxErrors     uint64
+	TxDropped    uint64
+	TxCompressed uint64
+
+	TxAbortedErrors   uint64
+	TxCarrierErrors   uint64
+	TxFIFOErrors      uint64
+	TxHeartbeatErrors uint64
+	TxWindowErrors    uint64
+
+	Collisions uint64
+	Multicast  uint64
+}
+
+// fields maps the statistics file names to the fields.
+func (s *NetStatistics) fields() map[string]*uint64 {
+	return map[string]*uint64{
+		"rx_bytes":      &s.RxBytes,
+		"rx_packets":    &s.RxPackets,
+		"rx_errors":     &s.RxErrors,
+		"rx_dropped":    &s.RxDropped,
+		"rx_compressed": &s.RxCompressed,
+		"rx_nohandler":  &s.RxNohandler,
+
+		"rx_crc_errors":    &s.RxCRCErrors,
+		"rx_fifo_errors":   &s.RxFIFOErrors,
+		"rx_frame_errors":  &s.RxFrameErrors,
+		"rx_length_errors": &s.RxLengthErrors,
+		"rx_missed_errors": &s.RxMissedErrors,
+		"rx_over_errors":   &s.RxOverErrors,
+
+		"tx_bytes":      &s.TxBytes,
+		"tx_packets":    &s.TxPackets,
+		"tx_errors":     &s.TxErrors,
+		"tx_dropped":    &s.TxDropped,
+		"tx_compressed": &s.TxCompressed,
+
+		"tx_aborted_errors":   &s.TxAbortedErrors,
+		"tx_carrier_errors":   &s.TxCarrierErrors,
+		"tx_fifo_errors":      &s.TxFIFOErrors,
+		"tx_heartbeat_errors": &s.TxHeartbeatErrors,
+		"tx_window_errors":    &s.TxWindowErrors,
+
+		"collisions": &s.Collisions,
+		"multicast":  &s.Multicast,
+	}
+}
+
+// ListNetInterfaces reads all '/sys/class/net/$IFACE', sorted by name.
+func ListNetInterfaces() ([]NetInterface, error) {
+	return defaultFS.ListNetInterfaces()
+}
+
+// ListNetInterfaces reads all '$ROOT/class/net/$IFACE', sorted by name.
+func (fs FS) ListNetInterfaces() ([]NetInterface, error) {
+	// sorted by name
+	fis, err := ioutil.ReadDir(fs.path("class", "net"))
+	if err != nil {
+		return nil, err
+	}
+
+	ns := make([]NetInterface, 0, len(fis))
+	for _, fi := range fis {
+		// skip files such as 'bonding_masters'
+		if !fi.IsDir() && fi.Mode()&os.ModeSymlink == 0 {
+			continue
+		}
+		n, err := fs.GetNetInterface(fi.Name())
+		if err != nil {
+			if os.IsNotExist(err) {
+				// interface may have been removed
+				continue
+			}
+			return nil, err
+		}
+		ns = append(ns, n)
+	}
+	return ns, nil
+}
+
+// GetNetInterface reads '/sys/class/net/$IFACE'.
+func GetNetInterface(name string) (NetInterface, error) {
+	return defaultFS.GetNetInterface(name)
+}
+
+// GetNetInterface reads '$ROOT/class/net/$IFACE'.
+func (fs FS) GetNetInterface(name string) (NetInterface, error) {
+	n := NetInterface{Name: name}
+
+	var err error
+	if n.OperState, err = readString(fs.path("class", "net", name, "operstate")); err != nil {
+		return NetInterface{}, err
+	}
+	if n.MTU, err = readUint(fs.path("class", "net", name, "mtu")); err != nil {
+		return NetInterface{}, err
+	}
+	if n.Address, err = readString(fs.path("class", "net", name, "address")); err != nil {
+		return NetInterface{}, err
+	}
+	if n.Type, err = readUint(fs.path("class", "net", name, "type")); err != nil {
+		return NetInterface{}, err
+	}
+
+	// reading 'speed' and 'duplex' fails with EINVAL
+	// for virtual links, or links without carrier
+	if n.Speed, err = readInt(fs.path("class", "net", name, "speed")); err != nil || n.Speed < 0 {
+		n.Speed = SpeedUnknown
+	}
+	if n.Duplex, err = readString(fs.path("class", "net", name, "duplex")); err != nil {
+		n.Duplex = "unknown"
+	}
+	if n.CarrierChanges, err = readUint(fs.path("class", "net", name, "carrier_changes")); err != nil {
+		// not in older kernels
+		n.CarrierChanges = 0
+	}
+
+	for fname, v := range n.Statistics.fields() {
+		if *v, err = readUint(fs.path("class", "net", name, "statistics", fname)); err != nil {
+			if os.IsNotExist(err) {
+				// not in older kernels (e.g. 'rx_nohandler')
+				continue
+			}
+			return NetInterface{}, err
+		}
+	}
+	return n, nil
+}
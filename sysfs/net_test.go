@@ -0,0 +1,124 @@
+package sysfs
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetNetInterface(t *testing.T) {
+	root, err := ioutil.TempDir(os.TempDir(), "sysfs-net-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	ifaces := map[string]map[string]string{
+		"eth0": {
+			"operstate":             "up\n",
+			"mtu":                   "9001\n",
+			"speed":                 "10000\n",
+			"duplex":                "full\n",
+			"address":               "02:42:ac:11:00:02\n",
+			"carrier_changes":       "2\n",
+			"type":                  "1\n",
+			"statistics/rx_bytes":   "1048576\n",
+			"statistics/tx_bytes":   "2048\n",
+			"statistics/rx_packets": "1000\n",
+			"statistics/tx_dropped": "3\n",
+		},
+		// 'speed' reads '-1', and 'duplex' is missing, as in 'veth'
+		"veth0a1b2c3": {
+			"operstate":       "up\n",
+			"mtu":             "1500\n",
+			"speed":           "-1\n",
+			"address":         "6a:1b:2c:3d:4e:5f\n",
+			"carrier_changes": "1\n",
+			"type":            "1\n",
+		},
+		// 'speed' and 'duplex' are missing, as in 'dummy'
+		"dummy0": {
+			"operstate": "unknown\n",
+			"mtu":       "1500\n",
+			"address":   "8e:1f:2a:3b:4c:5d\n",
+			"type":      "1\n",
+		},
+	}
+	for name, files := range ifaces {
+		for fname, txt := range files {
+			fpath := filepath.Join(root, "devices", "virtual", "net", name, fname)
+			if err = os.MkdirAll(filepath.Dir(fpath), 0777); err != nil {
+				t.Fatal(err)
+			}
+			if err = ioutil.WriteFile(fpath, []byte(txt), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	// '/sys/class/net/$IFACE' are symlinks to the devices,
+	// next to files such as 'bonding_masters'
+	netDir := filepath.Join(root, "class", "net")
+	if err = os.MkdirAll(netDir, 0777); err != nil {
+		t.Fatal(err)
+	}
+	for name := range ifaces {
+		if err = os.Symlink(filepath.Join(root, "devices", "virtual", "net", name), filepath.Join(netDir, name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = ioutil.WriteFile(filepath.Join(netDir, "bonding_masters"), []byte("\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	fs, err := NewFS(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ns, err := fs.ListNetInterfaces()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ns) != 3 {
+		t.Fatalf("expected 3 interfaces, got %+v", ns)
+	}
+	if ns[0].Name != "dummy0" || ns[1].Name != "eth0" || ns[2].Name != "veth0a1b2c3" {
+		t.Fatalf("unexpected order %q, %q, %q", ns[0].Name, ns[1].Name, ns[2].Name)
+	}
+
+	eth := ns[1]
+	if eth.OperState != "up" || eth.MTU != 9001 || eth.Speed != 10000 || eth.Duplex != "full" {
+		t.Fatalf("unexpected link %+v", eth)
+	}
+	if eth.Address != "02:42:ac:11:00:02" || eth.CarrierChanges != 2 || eth.Type != 1 {
+		t.Fatalf("unexpected link %+v", eth)
+	}
+	if eth.Statistics.RxBytes != 1048576 || eth.Statistics.TxBytes != 2048 || eth.Statistics.RxPackets != 1000 || eth.Statistics.TxDropped != 3 {
+		t.Fatalf("unexpected statistics %+v", eth.Statistics)
+	}
+
+	for _, n := range []NetInterface{ns[0], ns[2]} {
+		if n.Speed != SpeedUnknown || n.Duplex != "unknown" {
+			t.Fatalf("%q expected unknown speed and duplex, got %+v", n.Name, n)
+		}
+	}
+	if ns[0].OperState != "unknown" || ns[2].CarrierChanges != 1 {
+		t.Fatalf("unexpected links %+v", ns)
+	}
+
+	if _, err = fs.GetNetInterface("eth1"); !os.IsNotExist(err) {
+		t.Fatalf("expected not exist error, got %v", err)
+	}
+}
+
+func TestListNetInterfaces(t *testing.T) {
+	ns, err := ListNetInterfaces()
+	if err != nil {
+		t.Skip(err)
+	}
+	for _, n := range ns {
+		if n.Name == "lo" && n.Speed != SpeedUnknown {
+			t.Fatalf("expected unknown speed for 'lo', got %+v", n)
+		}
+	}
+}
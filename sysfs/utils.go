@@ -0,0 +1,43 @@
+package sysfs
+
+import (
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"github.com/gyuho/linux-inspect/pkg/fileutil"
+)
+
+func readFile(fpath string) ([]byte, error) {
+	f, err := fileutil.OpenToRead(fpath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ioutil.ReadAll(f)
+}
+
+// readString reads a single value file, without the trailing newline.
+func readString(fpath string) (string, error) {
+	d, err := readFile(fpath)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(d)), nil
+}
+
+func readUint(fpath string) (uint64, error) {
+	s, err := readString(fpath)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(s, 10, 64)
+}
+
+func readInt(fpath string) (int64, error) {
+	s, err := readString(fpath)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(s, 10, 64)
+}